@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
@@ -9,28 +11,74 @@ import (
 
 	"github.com/MonishJuspay/voice-orchestrator/internal/app/router"
 	"github.com/MonishJuspay/voice-orchestrator/internal/config"
+	"github.com/MonishJuspay/voice-orchestrator/internal/datastore/redis"
+	"github.com/MonishJuspay/voice-orchestrator/pkg/diagnostics"
 	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
+	"github.com/MonishJuspay/voice-orchestrator/pkg/metricsserver"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
 
+// Build information, injected via -ldflags "-X main.Version=..." (see Makefile)
+var (
+	Version   = "dev"
+	CommitSHA = "unknown"
+	BuildTime = "unknown"
+)
+
 func main() {
+	validateConfig := flag.Bool("validate-config", false, "strictly validate configuration, print it with credentials redacted and exit")
+	flag.Parse()
+
+	if *validateConfig {
+		if err := config.ValidateAndDump(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
+	// Prefer the version injected at build time over the APP_VERSION default
+	if Version != "dev" {
+		cfg.AppVersion = Version
+	}
+	buildInfo := diagnostics.BuildInfo{
+		Version:   cfg.AppVersion,
+		CommitSHA: CommitSHA,
+		BuildTime: BuildTime,
+	}
+
 	// Initialize logger
 	if err := logger.InitLogger(cfg.LogLevel, cfg.LogFormat); err != nil {
 		log.Fatalf("Failed to initialize logger: %v", err)
 	}
 	defer logger.Sync()
 
+	for _, warning := range cfg.Warnings {
+		logger.Warn("Invalid configuration value ignored, using default", zap.String("reason", warning))
+	}
+
 	logger.Info("Starting Voice Orchestrator Router",
 		zap.String("version", cfg.AppVersion),
+		zap.String("pod_name", cfg.PodName),
+		zap.String("commit_sha", CommitSHA),
+		zap.String("build_time", BuildTime),
 		zap.String("log_level", cfg.LogLevel),
 	)
 
+	if cfg.AdminAPIToken == "" {
+		logger.Warn("ADMIN_API_TOKEN is not set, admin endpoints will reject every request")
+	}
+
+	// Redis metrics are shared by all clients in the process and registered once here
+	prometheus.MustRegister(redis.Collector(), router.HealthScoreCollector())
+
 	// Create router server
 	srv, err := router.NewServer(cfg)
 	if err != nil {
@@ -50,6 +98,35 @@ func main() {
 		cancel()
 	}()
 
+	// Start diagnostics server on its own port
+	if cfg.DebugEndpointsEnabled {
+		go func() {
+			if err := diagnostics.Serve(ctx, cfg.GetDebugAddress(), buildInfo); err != nil {
+				logger.Error("Diagnostics server failed", zap.Error(err))
+			}
+		}()
+	}
+
+	// Start metrics server on its own port
+	if cfg.MetricsEnabled {
+		ms, err := metricsserver.New(metricsserver.Config{
+			Addr:              cfg.GetMetricsAddress(),
+			TLSCertFile:       cfg.MetricsTLSCertFile,
+			TLSKeyFile:        cfg.MetricsTLSKeyFile,
+			BasicAuthUsername: cfg.MetricsBasicAuthUsername,
+			BasicAuthPassword: cfg.MetricsBasicAuthPassword,
+		})
+		if err != nil {
+			logger.Fatal("Invalid metrics server configuration", zap.Error(err))
+		}
+		go func() {
+			// Like the API server, losing the metrics server is fatal; errors after shutdown began are not
+			if err := ms.Run(ctx); err != nil && ctx.Err() == nil {
+				logger.Fatal("Metrics server failed", zap.Error(err))
+			}
+		}()
+	}
+
 	// Start server
 	logger.Info("Router is ready to accept requests",
 		zap.String("address", cfg.GetServerAddress()),
@@ -0,0 +1,112 @@
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MonishJuspay/voice-orchestrator/internal/domain"
+)
+
+// Code is a stable, machine-readable error code returned to API clients
+type Code string
+
+const (
+	CodeInvalidRequest   Code = "INVALID_REQUEST"
+	CodeMerchantNotFound Code = "MERCHANT_NOT_FOUND"
+	CodePodNotFound      Code = "POD_NOT_FOUND"
+	CodeNoPodsAvailable  Code = "NO_PODS_AVAILABLE"
+	CodeQuotaExceeded    Code = "QUOTA_EXCEEDED"
+	CodeUnauthorized     Code = "UNAUTHORIZED"
+	CodeNotImplemented   Code = "NOT_IMPLEMENTED"
+	CodeInternal         Code = "INTERNAL"
+)
+
+// Error is an API error carrying a code, an HTTP status and a human message
+type Error struct {
+	Code    Code
+	Status  int
+	Message string
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// New creates a new API error
+func New(code Code, status int, message string) *Error {
+	return &Error{
+		Code:    code,
+		Status:  status,
+		Message: message,
+	}
+}
+
+// InvalidRequest returns an INVALID_REQUEST error with the given message
+func InvalidRequest(message string) *Error {
+	return New(CodeInvalidRequest, http.StatusBadRequest, message)
+}
+
+// Unauthorized returns an UNAUTHORIZED error for requests without valid credentials
+func Unauthorized() *Error {
+	return New(CodeUnauthorized, http.StatusUnauthorized, "missing or invalid credentials")
+}
+
+// NotImplemented returns a NOT_IMPLEMENTED error for the given feature
+func NotImplemented(feature string) *Error {
+	return New(CodeNotImplemented, http.StatusNotImplemented, feature+" is not implemented yet")
+}
+
+// Internal returns a generic INTERNAL error that leaks no details to the client
+func Internal() *Error {
+	return New(CodeInternal, http.StatusInternalServerError, "internal server error")
+}
+
+// FromError maps any error to an API error
+// Domain errors carry only the sentinel's fixed message, unknown errors become INTERNAL,
+// so that wrapped context (IDs, addresses, driver errors) never reaches the client
+func FromError(err error) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrMerchantNotFound):
+		return New(CodeMerchantNotFound, http.StatusNotFound, domain.ErrMerchantNotFound.Error())
+	case errors.Is(err, domain.ErrPodNotFound):
+		return New(CodePodNotFound, http.StatusNotFound, domain.ErrPodNotFound.Error())
+	case errors.Is(err, domain.ErrNoPodsAvailable):
+		return New(CodeNoPodsAvailable, http.StatusServiceUnavailable, domain.ErrNoPodsAvailable.Error())
+	case errors.Is(err, domain.ErrQuotaExceeded):
+		return New(CodeQuotaExceeded, http.StatusTooManyRequests, domain.ErrQuotaExceeded.Error())
+	case errors.Is(err, domain.ErrNotImplemented):
+		return New(CodeNotImplemented, http.StatusNotImplemented, domain.ErrNotImplemented.Error())
+	default:
+		return Internal()
+	}
+}
+
+// Body is the error object of the JSON envelope
+type Body struct {
+	Code      Code   `json:"code"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// Response is the JSON envelope returned for every API error
+type Response struct {
+	Error Body `json:"error"`
+}
+
+// NewResponse builds the JSON envelope for an API error
+func NewResponse(e *Error, requestID string) Response {
+	return Response{
+		Error: Body{
+			Code:      e.Code,
+			Message:   e.Message,
+			RequestID: requestID,
+		},
+	}
+}
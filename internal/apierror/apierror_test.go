@@ -0,0 +1,94 @@
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/MonishJuspay/voice-orchestrator/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedCode   Code
+		expectedStatus int
+	}{
+		{
+			name:           "api error passes through",
+			err:            InvalidRequest("bad merchant_id"),
+			expectedCode:   CodeInvalidRequest,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "wrapped api error",
+			err:            fmt.Errorf("handler: %w", NotImplemented("Pod allocation")),
+			expectedCode:   CodeNotImplemented,
+			expectedStatus: http.StatusNotImplemented,
+		},
+		{
+			name:           "merchant not found",
+			err:            fmt.Errorf("get merchant: %w", domain.ErrMerchantNotFound),
+			expectedCode:   CodeMerchantNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "pod not found",
+			err:            domain.ErrPodNotFound,
+			expectedCode:   CodePodNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "no pods available",
+			err:            domain.ErrNoPodsAvailable,
+			expectedCode:   CodeNoPodsAvailable,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:           "quota exceeded",
+			err:            domain.ErrQuotaExceeded,
+			expectedCode:   CodeQuotaExceeded,
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name:           "unknown error becomes internal",
+			err:            errors.New("dial tcp 10.0.0.1:6379: connection refused"),
+			expectedCode:   CodeInternal,
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiErr := FromError(tt.err)
+
+			assert.Equal(t, tt.expectedCode, apiErr.Code)
+			assert.Equal(t, tt.expectedStatus, apiErr.Status)
+		})
+	}
+}
+
+func TestInternalHidesDetails(t *testing.T) {
+	apiErr := FromError(errors.New("pq: password authentication failed"))
+
+	assert.NotContains(t, apiErr.Message, "password")
+}
+
+func TestDomainErrorsUseSentinelMessage(t *testing.T) {
+	apiErr := FromError(fmt.Errorf("get merchant merchant-123 from redis://10.0.0.1:6379: %w", domain.ErrMerchantNotFound))
+
+	assert.Equal(t, domain.ErrMerchantNotFound.Error(), apiErr.Message)
+	assert.NotContains(t, apiErr.Message, "merchant-123")
+	assert.NotContains(t, apiErr.Message, "10.0.0.1")
+}
+
+func TestNewResponse(t *testing.T) {
+	resp := NewResponse(InvalidRequest("merchant_id is required"), "req-123")
+
+	assert.Equal(t, CodeInvalidRequest, resp.Error.Code)
+	assert.Equal(t, "merchant_id is required", resp.Error.Message)
+	assert.Equal(t, "req-123", resp.Error.RequestID)
+}
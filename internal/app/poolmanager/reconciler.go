@@ -3,9 +3,11 @@ package poolmanager
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/MonishJuspay/voice-orchestrator/internal/config"
+	"github.com/MonishJuspay/voice-orchestrator/internal/datastore/redis"
 	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
 	"go.uber.org/zap"
 )
@@ -15,6 +17,9 @@ type PoolManager struct {
 	config            *config.Config
 	reconcileInterval time.Duration
 	stopChan          chan struct{}
+	stopOnce          sync.Once
+
+	redisClient *redis.Client
 }
 
 // New creates a new PoolManager instance
@@ -33,10 +38,20 @@ func (pm *PoolManager) Start(ctx context.Context) error {
 		zap.String("namespace", pm.config.K8sNamespace),
 	)
 
-	// TODO: Initialize clients
+	redisClient, err := redis.NewClient(ctx, pm.config.RedisURL, redis.Options{
+		PoolSize:             pm.config.RedisPoolSize,
+		MinIdleConns:         pm.config.RedisMinIdleConns,
+		SlowCommandThreshold: time.Duration(pm.config.RedisSlowCommandThresholdMs) * time.Millisecond,
+		KeyPrefix:            pm.config.RedisKeyPrefix,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize Redis client: %w", err)
+	}
+	pm.redisClient = redisClient
+
+	// TODO: Initialize remaining clients
 	// 1. Create K8s client
-	// 2. Create Redis client
-	// 3. Create Postgres client
+	// 2. Create Postgres client
 
 	ticker := time.NewTicker(pm.reconcileInterval)
 	defer ticker.Stop()
@@ -58,7 +73,7 @@ func (pm *PoolManager) Start(ctx context.Context) error {
 			}
 		case <-pm.stopChan:
 			logger.Info("Pool manager stopped")
-			return nil
+			return pm.shutdown()
 		}
 	}
 }
@@ -73,7 +88,7 @@ func (pm *PoolManager) reconcile(ctx context.Context) error {
 	// 2. Get current pod count from K8s for each merchant
 	// 3. Compare desired vs actual pod counts
 	// 4. Scale up/down K8s deployments as needed
-	// 5. Sync Redis with current K8s state
+	// 5. Sync Redis with current K8s state (NewSyncer over pm.redisClient)
 	// 6. Update metrics
 
 	duration := time.Since(start)
@@ -85,25 +100,37 @@ func (pm *PoolManager) reconcile(ctx context.Context) error {
 }
 
 // shutdown gracefully shuts down the pool manager
+// It is the only place that closes the clients Start created
 func (pm *PoolManager) shutdown() error {
 	logger.Info("Shutting down pool manager...")
 
-	// TODO: Cleanup resources
+	if err := pm.closeRedis(); err != nil {
+		logger.Error("Failed to close Redis client", zap.Error(err))
+	}
+
+	// TODO: Cleanup remaining resources
 	// 1. Close K8s client
-	// 2. Close Redis client
-	// 3. Close Postgres client
+	// 2. Close Postgres client
 
-	close(pm.stopChan)
 	logger.Info("Pool manager shutdown completed")
 	return nil
 }
 
-// Stop stops the pool manager
+// Stop stops the pool manager; Start closes the clients before it returns
+// Calling Stop more than once, or after the context was cancelled, is safe
 func (pm *PoolManager) Stop() error {
-	pm.stopChan <- struct{}{}
+	pm.stopOnce.Do(func() { close(pm.stopChan) })
 	return nil
 }
 
+// closeRedis closes the Redis client if Start created one
+func (pm *PoolManager) closeRedis() error {
+	if pm.redisClient == nil {
+		return nil
+	}
+	return pm.redisClient.Close()
+}
+
 // GetStatus returns the current status of the pool manager
 func (pm *PoolManager) GetStatus() map[string]interface{} {
 	// TODO: Implement status collection
@@ -3,46 +3,44 @@ package poolmanager
 import (
 	"context"
 	"fmt"
+
+	"github.com/MonishJuspay/voice-orchestrator/internal/datastore/redis"
 )
 
 // Syncer syncs state between Redis and Kubernetes
 type Syncer struct {
 	namespace string
+	repo      *redis.Repository
 }
 
 // NewSyncer creates a new Syncer instance
-func NewSyncer(namespace string) *Syncer {
+func NewSyncer(namespace string, repo *redis.Repository) *Syncer {
 	return &Syncer{
 		namespace: namespace,
+		repo:      repo,
 	}
 }
 
 // SyncMerchantPodCount syncs merchant pod count from K8s to Redis
 func (s *Syncer) SyncMerchantPodCount(ctx context.Context, merchantID string, podCount int) error {
-	// TODO: Implement Redis sync logic
-	// 1. Get Redis client
-	// 2. Set key: merchant:{merchant_id}:pod_count
-	// 3. Set expiry (optional)
-
-	return fmt.Errorf("not implemented: sync merchant %s pod count %d to Redis", merchantID, podCount)
+	if err := s.repo.SetMerchantPodCount(ctx, merchantID, podCount); err != nil {
+		return fmt.Errorf("failed to sync merchant %s pod count: %w", merchantID, err)
+	}
+	return nil
 }
 
 // GetMerchantPodCount retrieves merchant pod count from Redis
 func (s *Syncer) GetMerchantPodCount(ctx context.Context, merchantID string) (int, error) {
-	// TODO: Implement Redis get logic
-	// 1. Get Redis client
-	// 2. Get key: merchant:{merchant_id}:pod_count
-	// 3. Return pod count
-
-	return 0, fmt.Errorf("not implemented: get merchant %s pod count from Redis", merchantID)
+	return s.repo.GetMerchantPodCount(ctx, merchantID)
 }
 
-// SyncAllMerchants syncs all merchants' pod counts from K8s to Redis
+// SyncAllMerchants syncs all merchants' pod counts from K8s to Redis in a single pipeline
 func (s *Syncer) SyncAllMerchants(ctx context.Context, merchants map[string]int) error {
-	// TODO: Implement bulk Redis sync logic
-	// 1. Get Redis client
-	// 2. Use pipeline for bulk updates
-	// 3. Update all merchant pod counts
-
-	return fmt.Errorf("not implemented: sync all merchants to Redis")
+	if len(merchants) == 0 {
+		return nil
+	}
+	if err := s.repo.SetMerchantPodCounts(ctx, merchants); err != nil {
+		return fmt.Errorf("failed to sync all merchants: %w", err)
+	}
+	return nil
 }
@@ -3,42 +3,75 @@ package router
 import (
 	"net/http"
 
+	"github.com/MonishJuspay/voice-orchestrator/internal/apierror"
 	"github.com/MonishJuspay/voice-orchestrator/internal/config"
 	"github.com/MonishJuspay/voice-orchestrator/internal/domain"
+	"github.com/MonishJuspay/voice-orchestrator/internal/validate"
+	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
 	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
 )
 
 // Handler handles HTTP requests
 type Handler struct {
 	config *config.Config
+	redis  Pinger
+	health *healthChecker
 }
 
-// NewHandler creates a new handler instance
-func NewHandler(cfg *config.Config) *Handler {
+// NewHandler creates a new handler instance; redis may be nil when Redis is not configured
+func NewHandler(cfg *config.Config, redis Pinger) *Handler {
+	deps := make(map[string]Pinger)
+	if redis != nil {
+		deps["redis"] = redis
+	}
+
 	return &Handler{
 		config: cfg,
+		redis:  redis,
+		health: newHealthChecker(deps),
 	}
 }
 
 // Health returns the health status of the service
+// The plain form never touches dependencies so it stays cheap for liveness probes;
+// ?verbose=true adds dependency latencies and a 0-100 score for load balancer weighting
 func (h *Handler) Health(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{
+	resp := gin.H{
 		"status":  "healthy",
 		"service": h.config.AppName,
 		"version": h.config.AppVersion,
-	})
+	}
+	if c.Query("verbose") == "true" {
+		verbose := h.health.check(c.Request.Context())
+		resp["score"] = verbose.Score
+		resp["checks"] = verbose.Checks
+		resp["checked_at"] = verbose.CheckedAt
+	}
+
+	c.JSON(http.StatusOK, resp)
 }
 
 // Ready checks if the service is ready to accept requests
+// An unreachable Redis makes the router not ready, with only the error category exposed
 func (h *Handler) Ready(c *gin.Context) {
 	// TODO: Implement readiness checks
-	// - Check Redis connection
 	// - Check Postgres connection
 	// - Check K8s API access
-	c.JSON(http.StatusOK, gin.H{
-		"status": "ready",
+	status, code := "ready", http.StatusOK
+	redisCheck := "not configured"
+	if h.redis != nil {
+		redisCheck = "ok"
+		if dh := h.health.measure(c.Request.Context(), h.redis); dh.Error != "" {
+			redisCheck = dh.Error
+			status, code = "not ready", http.StatusServiceUnavailable
+		}
+	}
+
+	c.JSON(code, gin.H{
+		"status": status,
 		"checks": gin.H{
-			"redis":    "TODO",
+			"redis":    redisCheck,
 			"postgres": "TODO",
 			"k8s":      "TODO",
 		},
@@ -49,9 +82,14 @@ func (h *Handler) Ready(c *gin.Context) {
 func (h *Handler) AllocatePod(c *gin.Context) {
 	var req domain.PodAllocationRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondWithAPIError(c, apierror.InvalidRequest(err.Error()))
+		return
+	}
+	if err := validate.MerchantID("merchant_id", req.MerchantID); err != nil {
+		respondWithAPIError(c, apierror.InvalidRequest(err.Error()))
 		return
 	}
+	withLogFields(c, zap.String(logger.KeyMerchantID, req.MerchantID))
 
 	// TODO: Implement pod allocation logic
 	// 1. Validate merchant_id exists in Postgres
@@ -61,18 +99,18 @@ func (h *Handler) AllocatePod(c *gin.Context) {
 	// 5. Update Redis with allocation info
 	// 6. Return allocation response
 
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"error":   "not implemented yet",
-		"message": "Pod allocation logic will be implemented here",
-		"request": req,
-	})
+	respondWithAPIError(c, apierror.NotImplemented("Pod allocation"))
 }
 
 // CreateMerchant creates a new merchant
 func (h *Handler) CreateMerchant(c *gin.Context) {
 	var req domain.MerchantCreateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondWithAPIError(c, apierror.InvalidRequest(err.Error()))
+		return
+	}
+	if err := validate.MerchantName("name", req.Name); err != nil {
+		respondWithAPIError(c, apierror.InvalidRequest(err.Error()))
 		return
 	}
 
@@ -81,36 +119,39 @@ func (h *Handler) CreateMerchant(c *gin.Context) {
 	// 2. Insert into Postgres
 	// 3. Return created merchant
 
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"error":   "not implemented yet",
-		"message": "Merchant creation logic will be implemented here",
-		"request": req,
-	})
+	respondWithAPIError(c, apierror.NotImplemented("Merchant creation"))
 }
 
 // GetMerchant retrieves a merchant by ID
 func (h *Handler) GetMerchant(c *gin.Context) {
-	merchantID := c.Param("id")
+	if _, ok := bindMerchantID(c); !ok {
+		return
+	}
 
 	// TODO: Implement merchant retrieval logic
 	// 1. Query Postgres for merchant by ID
 	// 2. Return merchant data
 
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"error":       "not implemented yet",
-		"message":     "Merchant retrieval logic will be implemented here",
-		"merchant_id": merchantID,
-	})
+	respondWithAPIError(c, apierror.NotImplemented("Merchant retrieval"))
 }
 
 // UpdateMerchant updates a merchant
 func (h *Handler) UpdateMerchant(c *gin.Context) {
-	merchantID := c.Param("id")
+	if _, ok := bindMerchantID(c); !ok {
+		return
+	}
+
 	var req domain.MerchantUpdateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		respondWithAPIError(c, apierror.InvalidRequest(err.Error()))
 		return
 	}
+	if req.Name != nil {
+		if err := validate.MerchantName("name", *req.Name); err != nil {
+			respondWithAPIError(c, apierror.InvalidRequest(err.Error()))
+			return
+		}
+	}
 
 	// TODO: Implement merchant update logic
 	// 1. Validate request data
@@ -118,17 +159,14 @@ func (h *Handler) UpdateMerchant(c *gin.Context) {
 	// 3. Trigger pool manager reconciliation if desired_pod_count changed
 	// 4. Return updated merchant
 
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"error":       "not implemented yet",
-		"message":     "Merchant update logic will be implemented here",
-		"merchant_id": merchantID,
-		"request":     req,
-	})
+	respondWithAPIError(c, apierror.NotImplemented("Merchant update"))
 }
 
 // DeleteMerchant deletes a merchant
 func (h *Handler) DeleteMerchant(c *gin.Context) {
-	merchantID := c.Param("id")
+	if _, ok := bindMerchantID(c); !ok {
+		return
+	}
 
 	// TODO: Implement merchant deletion logic
 	// 1. Check if merchant has active allocations
@@ -136,9 +174,47 @@ func (h *Handler) DeleteMerchant(c *gin.Context) {
 	// 3. Clean up Redis data
 	// 4. Optionally scale down pods
 
-	c.JSON(http.StatusNotImplemented, gin.H{
-		"error":       "not implemented yet",
-		"message":     "Merchant deletion logic will be implemented here",
-		"merchant_id": merchantID,
-	})
+	respondWithAPIError(c, apierror.NotImplemented("Merchant deletion"))
+}
+
+// GetLogLevel returns the current log level and when a temporary override ends
+func (h *Handler) GetLogLevel(c *gin.Context) {
+	c.JSON(http.StatusOK, logger.CurrentLevel())
+}
+
+// SetLogLevel temporarily changes the log level of this replica
+func (h *Handler) SetLogLevel(c *gin.Context) {
+	var req logger.LevelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondWithAPIError(c, apierror.InvalidRequest(err.Error()))
+		return
+	}
+
+	status, err := req.Apply()
+	if err != nil {
+		respondWithAPIError(c, apierror.InvalidRequest(err.Error()))
+		return
+	}
+
+	logger.FromContext(c.Request.Context()).Warn("Log level changed",
+		zap.String("level", status.Level),
+		zap.Timep("revert_at", status.RevertAt),
+	)
+	c.JSON(http.StatusOK, status)
+}
+
+// bindMerchantID reads and validates the :id path parameter, responding with INVALID_REQUEST on failure
+func bindMerchantID(c *gin.Context) (string, bool) {
+	merchantID := c.Param("id")
+	if err := validate.MerchantID("id", merchantID); err != nil {
+		respondWithAPIError(c, apierror.InvalidRequest(err.Error()))
+		return "", false
+	}
+	withLogFields(c, zap.String(logger.KeyMerchantID, merchantID))
+	return merchantID, true
+}
+
+// withLogFields adds fields to the request-scoped logger so every later log line for the request carries them
+func withLogFields(c *gin.Context, fields ...zap.Field) {
+	c.Request = c.Request.WithContext(logger.WithFields(c.Request.Context(), fields...))
 }
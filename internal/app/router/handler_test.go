@@ -1,37 +1,83 @@
 package router
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/MonishJuspay/voice-orchestrator/internal/apierror"
+	"github.com/MonishJuspay/voice-orchestrator/internal/config"
+	"github.com/MonishJuspay/voice-orchestrator/internal/domain"
+	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 )
 
-func TestHealthHandler(t *testing.T) {
-	handler := &Handler{}
+// testAdminToken is the ADMIN_API_TOKEN of the test router; serve sends it with every request
+const testAdminToken = "test-admin-token"
+
+// newTestRouter builds a gin engine with the production routes and middleware
+func newTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	logger.Log = zap.NewNop()
+
+	cfg := &config.Config{
+		AppName:       "voice-orchestrator",
+		AppVersion:    "test",
+		AdminAPIToken: testAdminToken,
+	}
 
-	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	r := gin.New()
+	r.Use(RequestIDMiddleware())
+	r.Use(ServedByMiddleware("router-abc123"))
+	r.Use(gin.CustomRecovery(recoveryHandler))
+	setupRoutes(r, NewHandler(cfg, nil))
+	return r
+}
+
+// serve runs a request through the test router
+func serve(r *gin.Engine, method, path, body string) *httptest.ResponseRecorder {
+	var req *http.Request
+	if body == "" {
+		req = httptest.NewRequest(method, path, nil)
+	} else {
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
 	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+// decodeAPIError decodes the error envelope from a response
+func decodeAPIError(t *testing.T, w *httptest.ResponseRecorder) apierror.Body {
+	t.Helper()
+
+	var resp apierror.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return resp.Error
+}
 
-	handler.Health(w, req)
+func TestHealthHandler(t *testing.T) {
+	w := serve(newTestRouter(), http.MethodGet, "/health", "")
 
 	assert.Equal(t, http.StatusOK, w.Code)
 	assert.Contains(t, w.Body.String(), "healthy")
 }
 
 func TestReadinessHandler(t *testing.T) {
-	handler := &Handler{}
-
-	req := httptest.NewRequest(http.MethodGet, "/ready", nil)
-	w := httptest.NewRecorder()
-
-	handler.Readiness(w, req)
+	w := serve(newTestRouter(), http.MethodGet, "/ready", "")
 
-	// Currently returns 501 Not Implemented (stub)
-	assert.Equal(t, http.StatusNotImplemented, w.Code)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "ready")
 }
 
 func TestAllocatePodsHandler(t *testing.T) {
@@ -39,99 +85,344 @@ func TestAllocatePodsHandler(t *testing.T) {
 		name           string
 		requestBody    string
 		expectedStatus int
+		expectedCode   apierror.Code
 	}{
 		{
 			name:           "valid request",
 			requestBody:    `{"merchant_id":"merchant-123","pod_count":5}`,
 			expectedStatus: http.StatusNotImplemented, // Stub returns 501
+			expectedCode:   apierror.CodeNotImplemented,
 		},
 		{
 			name:           "invalid json",
 			requestBody:    `{invalid}`,
 			expectedStatus: http.StatusBadRequest,
+			expectedCode:   apierror.CodeInvalidRequest,
 		},
 		{
 			name:           "missing merchant_id",
 			requestBody:    `{"pod_count":5}`,
 			expectedStatus: http.StatusBadRequest,
+			expectedCode:   apierror.CodeInvalidRequest,
 		},
 		{
 			name:           "invalid pod_count",
 			requestBody:    `{"merchant_id":"merchant-123","pod_count":0}`,
 			expectedStatus: http.StatusBadRequest,
+			expectedCode:   apierror.CodeInvalidRequest,
 		},
 	}
 
-	handler := &Handler{}
+	r := newTestRouter()
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			req := httptest.NewRequest(http.MethodPost, "/api/v1/allocate", strings.NewReader(tt.requestBody))
-			req.Header.Set("Content-Type", "application/json")
-			w := httptest.NewRecorder()
-
-			handler.AllocatePods(w, req)
+			w := serve(r, http.MethodPost, "/api/v1/allocate", tt.requestBody)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedCode, decodeAPIError(t, w).Code)
 		})
 	}
 }
 
 func TestCreateMerchantHandler(t *testing.T) {
-	handler := &Handler{}
-
-	requestBody := `{"merchant_id":"merchant-123","desired_pod_count":10}`
-	req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/merchants", strings.NewReader(requestBody))
-	req.Header.Set("Content-Type", "application/json")
-	w := httptest.NewRecorder()
-
-	handler.CreateMerchant(w, req)
+	requestBody := `{"name":"Merchant 123","desired_pod_count":10}`
+	w := serve(newTestRouter(), http.MethodPost, "/api/v1/admin/merchants", requestBody)
 
 	// Currently returns 501 Not Implemented (stub)
 	assert.Equal(t, http.StatusNotImplemented, w.Code)
+	assert.Equal(t, apierror.CodeNotImplemented, decodeAPIError(t, w).Code)
 }
 
 func TestGetMerchantHandler(t *testing.T) {
-	handler := &Handler{}
-
-	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/merchants/merchant-123", nil)
-	w := httptest.NewRecorder()
-
-	handler.GetMerchant(w, req)
+	w := serve(newTestRouter(), http.MethodGet, "/api/v1/admin/merchants/merchant-123", "")
 
 	// Currently returns 501 Not Implemented (stub)
 	assert.Equal(t, http.StatusNotImplemented, w.Code)
+	assert.Equal(t, apierror.CodeNotImplemented, decodeAPIError(t, w).Code)
 }
 
 func TestUpdateMerchantHandler(t *testing.T) {
-	handler := &Handler{}
-
 	requestBody := `{"desired_pod_count":20}`
-	req := httptest.NewRequest(http.MethodPut, "/api/v1/admin/merchants/merchant-123", strings.NewReader(requestBody))
-	req.Header.Set("Content-Type", "application/json")
-	w := httptest.NewRecorder()
-
-	handler.UpdateMerchant(w, req)
+	w := serve(newTestRouter(), http.MethodPut, "/api/v1/admin/merchants/merchant-123", requestBody)
 
 	// Currently returns 501 Not Implemented (stub)
 	assert.Equal(t, http.StatusNotImplemented, w.Code)
+	assert.Equal(t, apierror.CodeNotImplemented, decodeAPIError(t, w).Code)
 }
 
 func TestDeleteMerchantHandler(t *testing.T) {
-	handler := &Handler{}
+	w := serve(newTestRouter(), http.MethodDelete, "/api/v1/admin/merchants/merchant-123", "")
+
+	// Currently returns 501 Not Implemented (stub)
+	assert.Equal(t, http.StatusNotImplemented, w.Code)
+	assert.Equal(t, apierror.CodeNotImplemented, decodeAPIError(t, w).Code)
+}
 
-	req := httptest.NewRequest(http.MethodDelete, "/api/v1/admin/merchants/merchant-123", nil)
+func TestHandlersRejectInvalidInput(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		body          string
+		expectedField string
+	}{
+		{
+			name:          "allocate oversized merchant_id",
+			method:        http.MethodPost,
+			path:          "/api/v1/allocate",
+			body:          `{"merchant_id":"` + strings.Repeat("m", 4096) + `","pod_count":1}`,
+			expectedField: "merchant_id",
+		},
+		{
+			name:          "allocate merchant_id with key separator",
+			method:        http.MethodPost,
+			path:          "/api/v1/allocate",
+			body:          `{"merchant_id":"merchant:123","pod_count":1}`,
+			expectedField: "merchant_id",
+		},
+		{
+			name:          "create blank name",
+			method:        http.MethodPost,
+			path:          "/api/v1/admin/merchants",
+			body:          `{"name":"   ","desired_pod_count":1}`,
+			expectedField: "name",
+		},
+		{
+			name:          "create name with control characters",
+			method:        http.MethodPost,
+			path:          "/api/v1/admin/merchants",
+			body:          `{"name":"Acme\u0000Corp","desired_pod_count":1}`,
+			expectedField: "name",
+		},
+		{
+			name:          "get merchant id with glob",
+			method:        http.MethodGet,
+			path:          "/api/v1/admin/merchants/merchant-*",
+			expectedField: "id",
+		},
+		{
+			name:          "update oversized merchant id",
+			method:        http.MethodPut,
+			path:          "/api/v1/admin/merchants/" + strings.Repeat("m", 65),
+			body:          `{"desired_pod_count":1}`,
+			expectedField: "id",
+		},
+		{
+			name:          "update blank name",
+			method:        http.MethodPut,
+			path:          "/api/v1/admin/merchants/merchant-123",
+			body:          `{"name":""}`,
+			expectedField: "name",
+		},
+		{
+			name:          "delete merchant id with encoded separator",
+			method:        http.MethodDelete,
+			path:          "/api/v1/admin/merchants/merchant%3A123",
+			expectedField: "id",
+		},
+	}
+
+	r := newTestRouter()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(r, tt.method, tt.path, tt.body)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			body := decodeAPIError(t, w)
+			assert.Equal(t, apierror.CodeInvalidRequest, body.Code)
+			assert.Contains(t, body.Message, "invalid "+tt.expectedField)
+		})
+	}
+}
+
+func TestErrorEnvelopeIncludesRequestID(t *testing.T) {
+	r := newTestRouter()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/merchants/merchant-123", nil)
+	req.Header.Set(RequestIDHeader, "req-abc")
 	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
 
-	handler.DeleteMerchant(w, req)
+	assert.Equal(t, "req-abc", w.Header().Get(RequestIDHeader))
+	assert.Equal(t, "req-abc", decodeAPIError(t, w).RequestID)
+}
 
-	// Currently returns 501 Not Implemented (stub)
-	assert.Equal(t, http.StatusNotImplemented, w.Code)
+func TestRequestIDReplacesMalformedCallerIDs(t *testing.T) {
+	tests := []struct {
+		name      string
+		requestID string
+		keep      bool
+	}{
+		{name: "well-formed", requestID: "req-abc_1.2", keep: true},
+		{name: "at length limit", requestID: strings.Repeat("a", 128), keep: true},
+		{name: "too long", requestID: strings.Repeat("a", 129)},
+		{name: "disallowed characters", requestID: "req abc<script>"},
+		{name: "log injection", requestID: "req\nlevel=error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRouter()
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/merchants/merchant-123", nil)
+			req.Header.Set(RequestIDHeader, tt.requestID)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			got := w.Header().Get(RequestIDHeader)
+			if tt.keep {
+				assert.Equal(t, tt.requestID, got)
+			} else {
+				assert.NotEqual(t, tt.requestID, got)
+				assert.Len(t, got, 32, "a fresh ID must be generated")
+			}
+			assert.Equal(t, got, decodeAPIError(t, w).RequestID)
+		})
+	}
+}
+
+func TestResponsesIncludeServedBy(t *testing.T) {
+	r := newTestRouter()
+
+	assert.Equal(t, "router-abc123", serve(r, http.MethodGet, "/health", "").Header().Get(ServedByHeader))
+	assert.Equal(t, "router-abc123", serve(r, http.MethodPost, "/api/v1/allocate", `{}`).Header().Get(ServedByHeader))
+}
+
+func TestPanicReturnsInternalError(t *testing.T) {
+	r := newTestRouter()
+	r.GET("/panic", func(c *gin.Context) {
+		panic("boom")
+	})
+
+	w := serve(r, http.MethodGet, "/panic", "")
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	body := decodeAPIError(t, w)
+	assert.Equal(t, apierror.CodeInternal, body.Code)
+	assert.NotEmpty(t, body.RequestID)
+	assert.NotContains(t, body.Message, "boom")
+}
+
+func TestDomainErrorDetailsStayInServerLogs(t *testing.T) {
+	r := newTestRouter()
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger.Log = zap.New(core)
+	r.GET("/missing", func(c *gin.Context) {
+		respondWithAPIError(c, fmt.Errorf("load merchant merchant-secret: %w", domain.ErrMerchantNotFound))
+	})
+
+	w := serve(r, http.MethodGet, "/missing", "")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	body := decodeAPIError(t, w)
+	assert.Equal(t, domain.ErrMerchantNotFound.Error(), body.Message)
+	assert.NotContains(t, w.Body.String(), "merchant-secret")
+
+	entries := logs.FilterMessage("Request failed").All()
+	require.Len(t, entries, 1)
+	assert.Contains(t, entries[0].ContextMap()["error"], "merchant-secret")
+}
+
+func TestRequestLogsCarryCorrelationFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+	}{
+		{name: "allocate", method: http.MethodPost, path: "/api/v1/allocate", body: `{"merchant_id":"merchant-123","pod_count":1}`},
+		{name: "get merchant", method: http.MethodGet, path: "/api/v1/admin/merchants/merchant-123"},
+		{name: "delete merchant", method: http.MethodDelete, path: "/api/v1/admin/merchants/merchant-123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.DebugLevel)
+			logger.Log = zap.New(core)
+
+			r := gin.New()
+			r.Use(RequestIDMiddleware())
+			r.Use(LoggingMiddleware())
+			setupRoutes(r, NewHandler(&config.Config{AdminAPIToken: testAdminToken}, nil))
+
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+testAdminToken)
+			req.Header.Set(RequestIDHeader, "req-abc")
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			entries := logs.FilterMessage("HTTP request").All()
+			require.Len(t, entries, 1)
+			fields := entries[0].ContextMap()
+			assert.Equal(t, "req-abc", fields[logger.KeyRequestID])
+			assert.Equal(t, "merchant-123", fields[logger.KeyMerchantID])
+		})
+	}
+}
+
+func TestLogLevelHandlers(t *testing.T) {
+	r := newTestRouter()
+	require.NoError(t, logger.InitLogger("info", "json"))
+	t.Cleanup(func() { logger.Log = zap.NewNop() })
+
+	w := serve(r, http.MethodPut, "/api/v1/admin/loglevel", `{"level":"debug","duration":"10m"}`)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = serve(r, http.MethodGet, "/api/v1/admin/loglevel", "")
+	require.Equal(t, http.StatusOK, w.Code)
+	var status logger.LevelStatus
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &status))
+	assert.Equal(t, "debug", status.Level)
+	require.NotNil(t, status.RevertAt)
+
+	w = serve(r, http.MethodPut, "/api/v1/admin/loglevel", `{"level":"verbose","duration":"10m"}`)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, apierror.CodeInvalidRequest, decodeAPIError(t, w).Code)
+
+	require.NoError(t, logger.InitLogger("info", "json"))
+}
+
+func TestAdminEndpointsRequireToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		configToken   string
+		authorization string
+		expected      int
+	}{
+		{name: "valid token", configToken: testAdminToken, authorization: "Bearer " + testAdminToken, expected: http.StatusOK},
+		{name: "missing header", configToken: testAdminToken, expected: http.StatusUnauthorized},
+		{name: "wrong token", configToken: testAdminToken, authorization: "Bearer nope", expected: http.StatusUnauthorized},
+		{name: "wrong scheme", configToken: testAdminToken, authorization: "Basic " + testAdminToken, expected: http.StatusUnauthorized},
+		{name: "token not configured", authorization: "Bearer ", expected: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			logger.Log = zap.NewNop()
+			r := gin.New()
+			r.Use(RequestIDMiddleware())
+			setupRoutes(r, NewHandler(&config.Config{AdminAPIToken: tt.configToken}, nil))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/loglevel", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expected, w.Code)
+			if tt.expected == http.StatusUnauthorized {
+				assert.Equal(t, apierror.CodeUnauthorized, decodeAPIError(t, w).Code)
+				assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
 }
 
 // TODO: Add tests for:
-// - Request validation
-// - Error handling
 // - Database interactions (with mocks)
 // - Redis caching (with mocks)
 // - K8s client interactions (with mocks)
@@ -140,25 +431,20 @@ func TestDeleteMerchantHandler(t *testing.T) {
 // - Authentication/authorization
 
 func BenchmarkHealthHandler(b *testing.B) {
-	handler := &Handler{}
-	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	r := newTestRouter()
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		w := httptest.NewRecorder()
-		handler.Health(w, req)
+		serve(r, http.MethodGet, "/health", "")
 	}
 }
 
 func BenchmarkAllocatePodsHandler(b *testing.B) {
-	handler := &Handler{}
+	r := newTestRouter()
 	requestBody := `{"merchant_id":"merchant-123","pod_count":5}`
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		req := httptest.NewRequest(http.MethodPost, "/api/v1/allocate", strings.NewReader(requestBody))
-		req.Header.Set("Content-Type", "application/json")
-		w := httptest.NewRecorder()
-		handler.AllocatePods(w, req)
+		serve(r, http.MethodPost, "/api/v1/allocate", requestBody)
 	}
 }
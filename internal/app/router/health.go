@@ -0,0 +1,152 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// healthCheckTimeout bounds each dependency check so a sick dependency cannot slow the health endpoint
+	healthCheckTimeout = 50 * time.Millisecond
+
+	// healthCacheTTL is how long verbose health results are reused
+	healthCacheTTL = time.Second
+)
+
+// healthScore exports the last computed health score; register it once via HealthScoreCollector
+var healthScore = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: "voice_orchestrator",
+	Name:      "health_score",
+	Help:      "Lowest dependency score (0-100) from the last verbose health check",
+})
+
+// HealthScoreCollector returns the gauge updated by every fresh verbose health check
+func HealthScoreCollector() prometheus.Collector {
+	return healthScore
+}
+
+// Pinger is a dependency whose reachability can be checked
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Error categories reported for a failed dependency check
+// /health is unauthenticated, so raw errors (dial addresses and the like) are never returned
+const (
+	checkErrorTimeout     = "timeout"
+	checkErrorUnreachable = "unreachable"
+)
+
+// DependencyHealth is the measured health of a single dependency
+type DependencyHealth struct {
+	LatencyMs float64 `json:"latency_ms"`
+	Score     int     `json:"score"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// VerboseHealth is the payload added to /health?verbose=true
+type VerboseHealth struct {
+	Score     int                         `json:"score"`
+	Checks    map[string]DependencyHealth `json:"checks"`
+	CheckedAt time.Time                   `json:"checked_at"`
+}
+
+// healthChecker measures dependency latencies and caches the result for load balancers that poll often
+type healthChecker struct {
+	deps    map[string]Pinger
+	timeout time.Duration
+	ttl     time.Duration
+
+	mu     sync.Mutex
+	cached *VerboseHealth
+}
+
+// newHealthChecker creates a checker for the given dependencies
+func newHealthChecker(deps map[string]Pinger) *healthChecker {
+	return &healthChecker{
+		deps:    deps,
+		timeout: healthCheckTimeout,
+		ttl:     healthCacheTTL,
+	}
+}
+
+// check returns the cached result if fresh, otherwise measures every dependency
+func (h *healthChecker) check(ctx context.Context) VerboseHealth {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.cached != nil && time.Since(h.cached.CheckedAt) < h.ttl {
+		return *h.cached
+	}
+
+	result := VerboseHealth{
+		Score:     100,
+		Checks:    make(map[string]DependencyHealth, len(h.deps)),
+		CheckedAt: time.Now(),
+	}
+	for name, dep := range h.deps {
+		dh := h.measure(ctx, dep)
+		result.Checks[name] = dh
+		result.Score = min(result.Score, dh.Score)
+	}
+
+	h.cached = &result
+	healthScore.Set(float64(result.Score))
+	return result
+}
+
+// measure pings a dependency under the check timeout
+func (h *healthChecker) measure(ctx context.Context, dep Pinger) DependencyHealth {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	// Run the ping separately so a client that ignores the deadline still cannot block us
+	errChan := make(chan error, 1)
+	start := time.Now()
+	go func() { errChan <- dep.Ping(ctx) }()
+
+	var err error
+	select {
+	case err = <-errChan:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	latency := time.Since(start)
+
+	dh := DependencyHealth{
+		LatencyMs: float64(latency.Microseconds()) / 1000,
+		Score:     latencyScore(latency, h.timeout),
+	}
+	if err != nil {
+		dh.Score = 0
+		dh.Error = categorizeCheckError(err)
+	}
+	return dh
+}
+
+// categorizeCheckError reduces a dependency error to a short category that is safe to expose
+func categorizeCheckError(err error) string {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return checkErrorTimeout
+	}
+	return checkErrorUnreachable
+}
+
+// latencyScore maps latency to 0-100: full marks up to a fifth of the timeout, then linearly down to 0 at the timeout
+func latencyScore(latency, timeout time.Duration) int {
+	healthy := timeout / 5
+	switch {
+	case latency <= healthy:
+		return 100
+	case latency >= timeout:
+		return 0
+	default:
+		return int(100 * (timeout - latency) / (timeout - healthy))
+	}
+}
@@ -0,0 +1,149 @@
+package router
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/MonishJuspay/voice-orchestrator/internal/config"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePinger records calls and answers after delay, or with err
+type fakePinger struct {
+	delay time.Duration
+	err   error
+	calls atomic.Int32
+}
+
+func (p *fakePinger) Ping(ctx context.Context) error {
+	p.calls.Add(1)
+	select {
+	case <-time.After(p.delay):
+		return p.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// newHealthRouter serves the health endpoint backed by the given Redis pinger
+func newHealthRouter(redis Pinger) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	setupRoutes(r, NewHandler(&config.Config{AppName: "voice-orchestrator"}, redis))
+	return r
+}
+
+func TestHealthPlainSkipsDependencies(t *testing.T) {
+	redis := &fakePinger{}
+	w := serve(newHealthRouter(redis), http.MethodGet, "/health", "")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "checks")
+	assert.Zero(t, redis.calls.Load())
+}
+
+func TestHealthVerbose(t *testing.T) {
+	tests := []struct {
+		name          string
+		redis         *fakePinger
+		expectedScore int
+		expectedError string
+	}{
+		{name: "fast redis", redis: &fakePinger{}, expectedScore: 100},
+		{name: "failing redis", redis: &fakePinger{err: errors.New("dial tcp 10.0.0.5:6379: connection refused")}, expectedScore: 0, expectedError: "unreachable"},
+		{name: "slow redis", redis: &fakePinger{delay: time.Second}, expectedScore: 0, expectedError: "timeout"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			w := serve(newHealthRouter(tt.redis), http.MethodGet, "/health?verbose=true", "")
+			elapsed := time.Since(start)
+
+			require.Equal(t, http.StatusOK, w.Code, "health stays 200 so liveness is unaffected")
+			assert.Less(t, elapsed, 500*time.Millisecond, "a slow dependency must not slow the health check")
+
+			var resp struct {
+				Status string                      `json:"status"`
+				Score  int                         `json:"score"`
+				Checks map[string]DependencyHealth `json:"checks"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, "healthy", resp.Status)
+			assert.Equal(t, tt.expectedScore, resp.Score)
+			require.Contains(t, resp.Checks, "redis")
+			assert.Equal(t, tt.expectedScore, resp.Checks["redis"].Score)
+			assert.Equal(t, tt.expectedError, resp.Checks["redis"].Error)
+			assert.NotContains(t, w.Body.String(), "10.0.0.5", "internal addresses must not leak")
+			assert.Equal(t, float64(tt.expectedScore), testutil.ToFloat64(healthScore))
+		})
+	}
+}
+
+func TestReadinessReportsRedis(t *testing.T) {
+	tests := []struct {
+		name           string
+		redis          Pinger
+		expectedStatus int
+		expectedRedis  string
+	}{
+		{name: "redis reachable", redis: &fakePinger{}, expectedStatus: http.StatusOK, expectedRedis: "ok"},
+		{name: "redis down", redis: &fakePinger{err: errors.New("dial tcp 10.0.0.5:6379: connection refused")}, expectedStatus: http.StatusServiceUnavailable, expectedRedis: "unreachable"},
+		{name: "redis slow", redis: &fakePinger{delay: time.Second}, expectedStatus: http.StatusServiceUnavailable, expectedRedis: "timeout"},
+		{name: "redis not configured", expectedStatus: http.StatusOK, expectedRedis: "not configured"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(newHealthRouter(tt.redis), http.MethodGet, "/ready", "")
+
+			require.Equal(t, tt.expectedStatus, w.Code)
+			var resp struct {
+				Checks map[string]string `json:"checks"`
+			}
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, tt.expectedRedis, resp.Checks["redis"])
+			assert.NotContains(t, w.Body.String(), "10.0.0.5")
+		})
+	}
+}
+
+func TestHealthVerboseCachesResults(t *testing.T) {
+	redis := &fakePinger{}
+	checker := newHealthChecker(map[string]Pinger{"redis": redis})
+	checker.ttl = 50 * time.Millisecond
+
+	first := checker.check(context.Background())
+	second := checker.check(context.Background())
+	assert.Equal(t, first, second)
+	assert.Equal(t, int32(1), redis.calls.Load())
+
+	time.Sleep(60 * time.Millisecond)
+	checker.check(context.Background())
+	assert.Equal(t, int32(2), redis.calls.Load())
+}
+
+func TestHealthVerboseWithoutDependencies(t *testing.T) {
+	result := newHealthChecker(map[string]Pinger{}).check(context.Background())
+
+	assert.Equal(t, 100, result.Score)
+	assert.Empty(t, result.Checks)
+}
+
+func TestLatencyScore(t *testing.T) {
+	timeout := 50 * time.Millisecond
+
+	assert.Equal(t, 100, latencyScore(time.Millisecond, timeout))
+	assert.Equal(t, 100, latencyScore(10*time.Millisecond, timeout))
+	assert.Equal(t, 50, latencyScore(30*time.Millisecond, timeout))
+	assert.Equal(t, 0, latencyScore(50*time.Millisecond, timeout))
+	assert.Equal(t, 0, latencyScore(time.Second, timeout))
+}
@@ -1,13 +1,95 @@
 package router
 
 import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/MonishJuspay/voice-orchestrator/internal/apierror"
 	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
 )
 
+const (
+	// RequestIDHeader carries the request ID in requests and responses
+	RequestIDHeader = "X-Request-ID"
+
+	// ServedByHeader names the replica that handled the request
+	ServedByHeader = "X-Served-By"
+
+	// requestIDKey is the gin context key holding the request ID
+	requestIDKey = "request_id"
+
+	// maxRequestIDLength bounds caller-supplied request IDs, which are echoed into headers and every log line
+	maxRequestIDLength = 128
+)
+
+// requestIDPattern is the character set accepted in caller-supplied request IDs
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// RequestIDMiddleware assigns every request an ID, reusing the caller's X-Request-ID if it is well-formed
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if !validRequestID(requestID) {
+			requestID = newRequestID()
+		}
+
+		c.Set(requestIDKey, requestID)
+		c.Writer.Header().Set(RequestIDHeader, requestID)
+		c.Request = c.Request.WithContext(
+			logger.WithFields(c.Request.Context(), zap.String(logger.KeyRequestID, requestID)),
+		)
+
+		c.Next()
+	}
+}
+
+// ServedByMiddleware tags every response with the replica that served it
+func ServedByMiddleware(podName string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if podName != "" {
+			c.Writer.Header().Set(ServedByHeader, podName)
+		}
+		c.Next()
+	}
+}
+
+// AdminAuthMiddleware requires "Authorization: Bearer <token>" on admin endpoints
+// An empty token rejects every request, so the admin API stays closed until ADMIN_API_TOKEN is set
+func AdminAuthMiddleware(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		given, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			c.Header("WWW-Authenticate", "Bearer")
+			respondWithAPIError(c, apierror.Unauthorized())
+			return
+		}
+		c.Next()
+	}
+}
+
+// validRequestID reports whether a caller-supplied request ID is short and free of unexpected characters
+func validRequestID(id string) bool {
+	return len(id) <= maxRequestIDLength && requestIDPattern.MatchString(id)
+}
+
+// newRequestID generates a random 16-byte hex request ID
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
 // LoggingMiddleware logs HTTP requests
 func LoggingMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -22,7 +104,8 @@ func LoggingMiddleware() gin.HandlerFunc {
 		duration := time.Since(start)
 		statusCode := c.Writer.Status()
 
-		logger.Info("HTTP request",
+		// Handlers may have added fields (e.g. merchant_id) to the request context
+		logger.FromContext(c.Request.Context()).Info("HTTP request",
 			zap.String("method", method),
 			zap.String("path", path),
 			zap.Int("status", statusCode),
@@ -32,12 +115,57 @@ func LoggingMiddleware() gin.HandlerFunc {
 	}
 }
 
+// RequestBodyLoggingMiddleware logs the request body, redacted, when the handler responds with >= 400
+// Up to maxBytes of the body are buffered; successful requests pay only for that copy
+func RequestBodyLoggingMiddleware(maxBytes int, redactFields []string) gin.HandlerFunc {
+	redactor := newBodyRedactor(redactFields)
+
+	return func(c *gin.Context) {
+		if c.Request.Body == nil || c.Request.Body == http.NoBody || maxBytes <= 0 {
+			c.Next()
+			return
+		}
+
+		// Buffer the head of the body and hand the handler an equivalent reader
+		// One extra byte tells us whether the body was truncated, even when ContentLength is unknown
+		captured, err := io.ReadAll(io.LimitReader(c.Request.Body, int64(maxBytes)+1))
+		if err != nil {
+			c.Next()
+			return
+		}
+		c.Request.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(captured), c.Request.Body), c.Request.Body}
+
+		c.Next()
+
+		statusCode := c.Writer.Status()
+		if statusCode < http.StatusBadRequest {
+			return
+		}
+
+		truncated := len(captured) > maxBytes
+		if truncated {
+			captured = captured[:maxBytes]
+		}
+		logger.FromContext(c.Request.Context()).Warn("HTTP request failed",
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.Int("status", statusCode),
+			zap.String("content_type", c.ContentType()),
+			zap.String("body", redactor.redact(c.ContentType(), captured, truncated)),
+			zap.Bool("body_truncated", truncated),
+		)
+	}
+}
+
 // CORSMiddleware handles CORS headers
 func CORSMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, X-Request-ID, Authorization, accept, origin, Cache-Control, X-Requested-With")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
 
 		if c.Request.Method == "OPTIONS" {
@@ -0,0 +1,186 @@
+package router
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// newBodyLoggingRouter returns a router whose /webhook responds with status and records the body it read
+func newBodyLoggingRouter(maxBytes, status int, received *string) (*gin.Engine, *observer.ObservedLogs) {
+	gin.SetMode(gin.TestMode)
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger.Log = zap.New(core)
+
+	r := gin.New()
+	r.Use(RequestBodyLoggingMiddleware(maxBytes, []string{"From", "To", "CallerName"}))
+	r.POST("/webhook", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		*received = string(body)
+		c.Status(status)
+	})
+	return r, logs
+}
+
+func TestRequestBodyLoggingRedactsFormOnError(t *testing.T) {
+	var received string
+	r, logs := newBodyLoggingRouter(8192, http.StatusBadRequest, &received)
+
+	form := url.Values{
+		"CallSid":    {"CA1234567890abcdef"},
+		"From":       {"+14155551234"},
+		"To":         {"+14155559876"},
+		"CallerName": {"Jane Doe"},
+	}.Encode()
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(form))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, form, received, "handler must see the untouched body")
+
+	entries := logs.FilterMessage("HTTP request failed").All()
+	require.Len(t, entries, 1)
+	logged, err := url.ParseQuery(entries[0].ContextMap()["body"].(string))
+	require.NoError(t, err)
+
+	assert.Equal(t, "CA1234567890abcdef", logged.Get("CallSid"))
+	assert.Equal(t, "********1234", logged.Get("From"))
+	assert.Equal(t, "********9876", logged.Get("To"))
+	assert.Equal(t, "**** Doe", logged.Get("CallerName"))
+	assert.NotContains(t, entries[0].ContextMap()["body"], "415555")
+}
+
+func TestRequestBodyLoggingRedactsNestedJSON(t *testing.T) {
+	var received string
+	r, logs := newBodyLoggingRouter(8192, http.StatusInternalServerError, &received)
+
+	body := `{"merchant_id":"merchant-123","caller":{"from":"+14155551234","to":12345}}`
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	entries := logs.FilterMessage("HTTP request failed").All()
+	require.Len(t, entries, 1)
+	logged := entries[0].ContextMap()["body"].(string)
+
+	assert.Contains(t, logged, `"merchant_id":"merchant-123"`)
+	assert.Contains(t, logged, `"from":"********1234"`)
+	assert.Contains(t, logged, `"to":"[REDACTED]"`)
+	assert.NotContains(t, logged, "415555")
+}
+
+func TestRequestBodyLoggingSkipsSuccess(t *testing.T) {
+	var received string
+	r, logs := newBodyLoggingRouter(8192, http.StatusOK, &received)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader("From=%2B14155551234"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "From=%2B14155551234", received)
+	assert.Zero(t, logs.Len())
+}
+
+func TestRequestBodyLoggingPassesFullBodyBeyondLimit(t *testing.T) {
+	var received string
+	r, logs := newBodyLoggingRouter(16, http.StatusBadRequest, &received)
+
+	body := `{"merchant_id":"merchant-123","from":"+14155551234"}`
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, body, received)
+
+	entries := logs.FilterMessage("HTTP request failed").All()
+	require.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	assert.Equal(t, true, fields["body_truncated"])
+	assert.NotContains(t, fields["body"], "415555", "truncated JSON cannot be redacted and must not be logged")
+}
+
+func TestRequestBodyLoggingDetectsTruncationOfChunkedBodies(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		truncated bool
+	}{
+		{name: "exactly at limit", body: "From=%2B14155551", truncated: false},
+		{name: "beyond limit", body: "From=%2B14155551234", truncated: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received string
+			r, logs := newBodyLoggingRouter(16, http.StatusBadRequest, &received)
+
+			// A reader of unknown length makes the request chunked, i.e. ContentLength -1
+			req := httptest.NewRequest(http.MethodPost, "/webhook", io.MultiReader(strings.NewReader(tt.body)))
+			req.ContentLength = -1
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			r.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tt.body, received)
+
+			entries := logs.FilterMessage("HTTP request failed").All()
+			require.Len(t, entries, 1)
+			assert.Equal(t, tt.truncated, entries[0].ContextMap()["body_truncated"])
+		})
+	}
+}
+
+func TestRequestBodyLoggingMasksFormValueCutByLimit(t *testing.T) {
+	var received string
+	r, logs := newBodyLoggingRouter(31, http.StatusBadRequest, &received)
+
+	// The limit cuts the body after "From=%2B14155551", inside the redacted value
+	body := "CallSid=CA1234&From=%2B14155551234&To=%2B14155559876"
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, body, received)
+
+	entries := logs.FilterMessage("HTTP request failed").All()
+	require.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	assert.Equal(t, true, fields["body_truncated"])
+	logged, err := url.ParseQuery(fields["body"].(string))
+	require.NoError(t, err)
+
+	assert.Equal(t, "CA1234", logged.Get("CallSid"))
+	assert.Equal(t, "[REDACTED]", logged.Get("From"))
+	assert.NotContains(t, fields["body"], "5551")
+}
+
+func TestRequestBodyLoggingOmitsUnknownContentTypes(t *testing.T) {
+	var received string
+	r, logs := newBodyLoggingRouter(8192, http.StatusBadRequest, &received)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader("From: +14155551234"))
+	req.Header.Set("Content-Type", "text/plain")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	entries := logs.FilterMessage("HTTP request failed").All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "<18 bytes omitted: body could not be redacted>", entries[0].ContextMap()["body"])
+}
+
+func TestMaskValue(t *testing.T) {
+	assert.Equal(t, "", maskValue(""))
+	assert.Equal(t, "***", maskValue("abc"))
+	assert.Equal(t, "****", maskValue("1234"))
+	assert.Equal(t, "*1234", maskValue("51234"))
+	assert.Equal(t, "**ñé12", maskValue("abñé12"))
+}
@@ -0,0 +1,77 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/MonishJuspay/voice-orchestrator/internal/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ginParam matches gin path parameters such as :id
+var ginParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// specOperations returns the "METHOD /path" pairs described by the embedded spec
+func specOperations(t *testing.T) map[string]bool {
+	t.Helper()
+
+	var spec struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(openAPISpec, &spec))
+
+	ops := make(map[string]bool)
+	for path, item := range spec.Paths {
+		for method := range item {
+			if method == "parameters" {
+				continue
+			}
+			ops[strings.ToUpper(method)+" "+path] = true
+		}
+	}
+	return ops
+}
+
+// TestOpenAPISpecCoversRoutes fails when a route is added or removed without updating openapi.json
+func TestOpenAPISpecCoversRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	setupRoutes(r, NewHandler(&config.Config{DebugEndpointsEnabled: true}, nil))
+
+	ops := specOperations(t)
+	routes := make(map[string]bool)
+	for _, route := range r.Routes() {
+		op := route.Method + " " + ginParam.ReplaceAllString(route.Path, "{$1}")
+		routes[op] = true
+		assert.True(t, ops[op], "route %s is missing from openapi.json", op)
+	}
+	for op := range ops {
+		assert.True(t, routes[op], "openapi.json describes %s, which is not registered", op)
+	}
+}
+
+func TestOpenAPISpecEndpoint(t *testing.T) {
+	w := serve(newTestRouter(), http.MethodGet, "/api/v1/openapi.json", "")
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, string(openAPISpec), w.Body.String())
+}
+
+func TestOpenAPIDocsRequireDebugEndpoints(t *testing.T) {
+	w := serve(newTestRouter(), http.MethodGet, "/api/v1/docs", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	setupRoutes(r, NewHandler(&config.Config{DebugEndpointsEnabled: true}, nil))
+
+	w = serve(r, http.MethodGet, "/api/v1/docs", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "swagger-ui")
+}
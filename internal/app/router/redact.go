@@ -0,0 +1,129 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+)
+
+// bodyRedactor masks sensitive fields in captured request bodies
+type bodyRedactor struct {
+	fields map[string]bool
+}
+
+// newBodyRedactor creates a redactor for the given field names (case-insensitive)
+func newBodyRedactor(fields []string) *bodyRedactor {
+	r := &bodyRedactor{fields: make(map[string]bool, len(fields))}
+	for _, field := range fields {
+		if field = strings.TrimSpace(field); field != "" {
+			r.fields[strings.ToLower(field)] = true
+		}
+	}
+	return r
+}
+
+// redact returns a loggable form of body with sensitive fields masked
+// Bodies that cannot be parsed are never logged verbatim, since they cannot be redacted
+func (r *bodyRedactor) redact(contentType string, body []byte, truncated bool) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	switch contentType {
+	case binding.MIMEPOSTForm:
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return unloggableBody(body)
+		}
+		// A truncated body ends inside its last pair; the last four characters of that
+		// fragment are digits from the middle of the value, so it is masked in full
+		cutKey := ""
+		if truncated {
+			cutKey = lastFormKey(body)
+		}
+		for key, vals := range values {
+			if r.fields[strings.ToLower(key)] {
+				for i := range vals {
+					if key == cutKey && i == len(vals)-1 {
+						vals[i] = "[REDACTED]"
+					} else {
+						vals[i] = maskValue(vals[i])
+					}
+				}
+			}
+		}
+		return values.Encode()
+	case binding.MIMEJSON:
+		if truncated {
+			return unloggableBody(body)
+		}
+		var payload interface{}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return unloggableBody(body)
+		}
+		redacted, err := json.Marshal(r.redactJSON(payload))
+		if err != nil {
+			return unloggableBody(body)
+		}
+		return string(redacted)
+	default:
+		return unloggableBody(body)
+	}
+}
+
+// redactJSON masks matching keys at any depth of a decoded JSON value
+func (r *bodyRedactor) redactJSON(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for key, child := range val {
+			if !r.fields[strings.ToLower(key)] {
+				val[key] = r.redactJSON(child)
+				continue
+			}
+			if s, ok := child.(string); ok {
+				val[key] = maskValue(s)
+			} else {
+				val[key] = "[REDACTED]"
+			}
+		}
+		return val
+	case []interface{}:
+		for i, child := range val {
+			val[i] = r.redactJSON(child)
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+// maskValue keeps only the last four characters of a value
+func maskValue(value string) string {
+	runes := []rune(value)
+	if len(runes) <= 4 {
+		return strings.Repeat("*", len(runes))
+	}
+	return strings.Repeat("*", len(runes)-4) + string(runes[len(runes)-4:])
+}
+
+// lastFormKey returns the decoded key of the last pair of a form body
+func lastFormKey(body []byte) string {
+	pair := string(body)
+	if i := strings.LastIndexByte(pair, '&'); i >= 0 {
+		pair = pair[i+1:]
+	}
+	key, _, _ := strings.Cut(pair, "=")
+	key, err := url.QueryUnescape(key)
+	if err != nil {
+		return ""
+	}
+	return key
+}
+
+// unloggableBody describes a body that is not logged because it cannot be redacted
+func unloggableBody(body []byte) string {
+	return fmt.Sprintf("<%d bytes omitted: body could not be redacted>", len(body))
+}
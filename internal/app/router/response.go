@@ -0,0 +1,40 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MonishJuspay/voice-orchestrator/internal/apierror"
+	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// respondWithAPIError writes the JSON error envelope for err and aborts the request
+func respondWithAPIError(c *gin.Context, err error) {
+	apiErr := apierror.FromError(err)
+	requestID := c.GetString(requestIDKey)
+
+	// The client only sees the fixed message, the wrapped error is kept in the server logs
+	var direct *apierror.Error
+	switch {
+	case apiErr.Code == apierror.CodeInternal:
+		logger.FromContext(c.Request.Context()).Error("Request failed with internal error",
+			zap.String("path", c.Request.URL.Path),
+			zap.Error(err),
+		)
+	case !errors.As(err, &direct):
+		logger.FromContext(c.Request.Context()).Info("Request failed",
+			zap.String("path", c.Request.URL.Path),
+			zap.String("code", string(apiErr.Code)),
+			zap.Error(err),
+		)
+	}
+
+	c.AbortWithStatusJSON(apiErr.Status, apierror.NewResponse(apiErr, requestID))
+}
+
+// recoveryHandler turns panics into an INTERNAL error envelope
+func recoveryHandler(c *gin.Context, recovered any) {
+	respondWithAPIError(c, fmt.Errorf("panic: %v", recovered))
+}
@@ -16,13 +16,22 @@ func setupRoutes(r *gin.Engine, h *Handler) {
 		// Pod allocation endpoint
 		v1.POST("/allocate", h.AllocatePod)
 
-		// Admin endpoints (future implementation)
-		admin := v1.Group("/admin")
+		// API description, with the interactive UI only alongside the other debug endpoints
+		v1.GET("/openapi.json", h.OpenAPISpec)
+		if h.config.DebugEndpointsEnabled {
+			v1.GET("/docs", h.OpenAPIDocs)
+		}
+
+		// Admin endpoints (future implementation), all behind ADMIN_API_TOKEN
+		admin := v1.Group("/admin", AdminAuthMiddleware(h.config.AdminAPIToken))
 		{
 			admin.POST("/merchants", h.CreateMerchant)
 			admin.GET("/merchants/:id", h.GetMerchant)
 			admin.PUT("/merchants/:id", h.UpdateMerchant)
 			admin.DELETE("/merchants/:id", h.DeleteMerchant)
+
+			admin.GET("/loglevel", h.GetLogLevel)
+			admin.PUT("/loglevel", h.SetLogLevel)
 		}
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/MonishJuspay/voice-orchestrator/internal/config"
+	"github.com/MonishJuspay/voice-orchestrator/internal/datastore/redis"
 	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -19,6 +20,7 @@ type Server struct {
 	router     *gin.Engine
 	httpServer *http.Server
 	handler    *Handler
+	redis      *redis.Client
 }
 
 // NewServer creates a new HTTP server instance
@@ -32,12 +34,31 @@ func NewServer(cfg *config.Config) (*Server, error) {
 
 	// Create router with default middleware
 	r := gin.New()
-	r.Use(gin.Recovery())
+	r.Use(RequestIDMiddleware())
+	r.Use(ServedByMiddleware(cfg.PodName))
+	r.Use(gin.CustomRecovery(recoveryHandler))
 	r.Use(LoggingMiddleware())
+	r.Use(RequestBodyLoggingMiddleware(cfg.LogBodyMaxBytes, cfg.LogBodyRedactFields))
 	r.Use(CORSMiddleware())
 
+	// Connect to Redis; the router starts while Redis is down and reports not ready until it is reachable
+	redisClient, err := redis.Open(cfg.RedisURL, redis.Options{
+		PoolSize:             cfg.RedisPoolSize,
+		MinIdleConns:         cfg.RedisMinIdleConns,
+		SlowCommandThreshold: time.Duration(cfg.RedisSlowCommandThresholdMs) * time.Millisecond,
+		KeyPrefix:            cfg.RedisKeyPrefix,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Redis client: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := redisClient.Ping(ctx); err != nil {
+		logger.Warn("Redis is unreachable, /ready will fail until it recovers", zap.Error(err))
+	}
+
 	// Create handler
-	handler := NewHandler(cfg)
+	handler := NewHandler(cfg, redisClient)
 
 	// Setup routes
 	setupRoutes(r, handler)
@@ -56,6 +77,7 @@ func NewServer(cfg *config.Config) (*Server, error) {
 		router:     r,
 		httpServer: httpServer,
 		handler:    handler,
+		redis:      redisClient,
 	}, nil
 }
 
@@ -93,6 +115,9 @@ func (s *Server) Shutdown() error {
 	if err := s.httpServer.Shutdown(ctx); err != nil {
 		return fmt.Errorf("server shutdown failed: %w", err)
 	}
+	if err := s.redis.Close(); err != nil {
+		logger.Error("Failed to close Redis client", zap.Error(err))
+	}
 
 	logger.Info("HTTP server stopped successfully")
 	return nil
@@ -1,9 +1,15 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
+
+	"github.com/MonishJuspay/voice-orchestrator/internal/datastore/redis"
 )
 
 // Config holds all application configuration
@@ -13,8 +19,14 @@ type Config struct {
 	ServerHost string
 
 	// Database configuration
-	PostgresURL string
-	RedisURL    string
+	PostgresURL       string
+	RedisURL          string
+	RedisKeyPrefix    string
+	RedisPoolSize     int
+	RedisMinIdleConns int
+
+	// RedisSlowCommandThresholdMs is the latency above which Redis commands are logged and counted
+	RedisSlowCommandThresholdMs int
 
 	// Kubernetes configuration
 	K8sNamespace      string
@@ -28,26 +40,88 @@ type Config struct {
 	LogLevel  string
 	LogFormat string
 
+	// Request bodies of failed requests are logged with these fields masked
+	LogBodyMaxBytes     int
+	LogBodyRedactFields []string
+
+	// Admin API configuration (bearer token for /api/v1/admin; empty rejects every admin request)
+	AdminAPIToken string
+
+	// Diagnostics configuration (pprof and /debug/vars, never on the API port)
+	DebugEndpointsEnabled bool
+	DebugHost             string
+	DebugPort             string
+
+	// Metrics server configuration (Prometheus /metrics on its own port)
+	MetricsEnabled           bool
+	MetricsPort              string
+	MetricsTLSCertFile       string
+	MetricsTLSKeyFile        string
+	MetricsBasicAuthUsername string
+	MetricsBasicAuthPassword string
+
+	// StrictConfig turns unparsable values and soft checks into startup errors
+	StrictConfig bool
+
+	// Warnings lists values that could not be parsed and fell back to defaults
+	Warnings []string `json:",omitempty"`
+
 	// Application metadata
 	AppName    string
 	AppVersion string
+
+	// PodName identifies this replica; Kubernetes injects it via the downward API
+	PodName string
 }
 
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
+	return load(false)
+}
+
+// load reads the environment; with forceStrict, invalid values fail regardless of STRICT_CONFIG
+func load(forceStrict bool) (*Config, error) {
+	env := &envLoader{}
 	cfg := &Config{
-		ServerPort:               getEnv("SERVER_PORT", "8080"),
-		ServerHost:               getEnv("SERVER_HOST", "0.0.0.0"),
-		PostgresURL:              getEnv("POSTGRES_URL", ""),
-		RedisURL:                 getEnv("REDIS_URL", "redis://localhost:6379/0"),
-		K8sNamespace:             getEnv("K8S_NAMESPACE", "default"),
-		K8sInCluster:             getEnvBool("K8S_IN_CLUSTER", false),
-		K8sKubeConfigPath:        getEnv("K8S_KUBECONFIG_PATH", ""),
-		ReconcileIntervalSeconds: getEnvInt("RECONCILE_INTERVAL_SECONDS", 10),
-		LogLevel:                 getEnv("LOG_LEVEL", "info"),
-		LogFormat:                getEnv("LOG_FORMAT", "json"),
-		AppName:                  "voice-orchestrator",
-		AppVersion:               getEnv("APP_VERSION", "dev"),
+		ServerPort:                  env.getEnv("SERVER_PORT", "8080"),
+		ServerHost:                  env.getEnv("SERVER_HOST", "0.0.0.0"),
+		PostgresURL:                 env.getEnv("POSTGRES_URL", ""),
+		RedisURL:                    env.getEnv("REDIS_URL", "redis://localhost:6379/0"),
+		RedisKeyPrefix:              env.lookupEnv("REDIS_KEY_PREFIX", redis.DefaultKeyPrefix),
+		RedisPoolSize:               env.getEnvInt("REDIS_POOL_SIZE", 10),
+		RedisMinIdleConns:           env.getEnvInt("REDIS_MIN_IDLE_CONNS", 2),
+		RedisSlowCommandThresholdMs: env.getEnvInt("REDIS_SLOW_COMMAND_THRESHOLD_MS", 50),
+		K8sNamespace:                env.getEnv("K8S_NAMESPACE", "default"),
+		K8sInCluster:                env.getEnvBool("K8S_IN_CLUSTER", false),
+		K8sKubeConfigPath:           env.getEnv("K8S_KUBECONFIG_PATH", ""),
+		ReconcileIntervalSeconds:    env.getEnvInt("RECONCILE_INTERVAL_SECONDS", 10),
+		LogLevel:                    env.getEnv("LOG_LEVEL", "info"),
+		LogFormat:                   env.getEnv("LOG_FORMAT", "json"),
+		LogBodyMaxBytes:             env.getEnvInt("LOG_BODY_MAX_BYTES", 8192),
+		LogBodyRedactFields:         env.getEnvSlice("LOG_BODY_REDACT_FIELDS", []string{"From", "To", "CallerName"}),
+		AdminAPIToken:               env.getEnv("ADMIN_API_TOKEN", ""),
+		DebugEndpointsEnabled:       env.getEnvBool("DEBUG_ENDPOINTS_ENABLED", false),
+		DebugHost:                   env.getEnv("DEBUG_HOST", "127.0.0.1"),
+		DebugPort:                   env.getEnv("DEBUG_PORT", "6060"),
+		MetricsEnabled:              env.getEnvBool("METRICS_ENABLED", true),
+		MetricsPort:                 env.getEnv("METRICS_PORT", "9091"),
+		MetricsTLSCertFile:          env.getEnv("METRICS_TLS_CERT_FILE", ""),
+		MetricsTLSKeyFile:           env.getEnv("METRICS_TLS_KEY_FILE", ""),
+		MetricsBasicAuthUsername:    env.getEnv("METRICS_BASIC_AUTH_USERNAME", ""),
+		MetricsBasicAuthPassword:    env.getEnv("METRICS_BASIC_AUTH_PASSWORD", ""),
+		StrictConfig:                env.getEnvBool("STRICT_CONFIG", false),
+		AppName:                     "voice-orchestrator",
+		AppVersion:                  env.getEnv("APP_VERSION", "dev"),
+		PodName:                     env.getEnv("POD_NAME", hostname()),
+	}
+	cfg.StrictConfig = cfg.StrictConfig || forceStrict
+
+	// Unparsable values fall back to their defaults unless strict mode is on
+	if len(env.invalid) > 0 {
+		if cfg.StrictConfig {
+			return nil, fmt.Errorf("config validation failed: %s", strings.Join(env.invalid, "; "))
+		}
+		cfg.Warnings = env.invalid
 	}
 
 	// Validate required configuration
@@ -58,6 +132,35 @@ func Load() (*Config, error) {
 	return cfg, nil
 }
 
+// ValidateAndDump strictly loads configuration and writes it to w with credentials redacted
+func ValidateAndDump(w io.Writer) error {
+	cfg, err := load(true)
+	if err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(cfg.Redacted(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+// Redacted returns a copy of the config with passwords removed
+func (c *Config) Redacted() *Config {
+	redacted := *c
+	redacted.PostgresURL = redactURL(c.PostgresURL)
+	redacted.RedisURL = redactURL(c.RedisURL)
+	if c.MetricsBasicAuthPassword != "" {
+		redacted.MetricsBasicAuthPassword = "xxxxx"
+	}
+	if c.AdminAPIToken != "" {
+		redacted.AdminAPIToken = "xxxxx"
+	}
+	return &redacted
+}
+
 // Validate checks if required configuration is present
 func (c *Config) Validate() error {
 	// PostgresURL is required for production
@@ -76,6 +179,47 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid log level: %s (must be debug/info/warn/error)", c.LogLevel)
 	}
 
+	if c.RedisPoolSize < 1 {
+		return fmt.Errorf("REDIS_POOL_SIZE must be at least 1, got %d", c.RedisPoolSize)
+	}
+	if c.RedisMinIdleConns < 0 || c.RedisMinIdleConns > c.RedisPoolSize {
+		return fmt.Errorf("REDIS_MIN_IDLE_CONNS must be between 0 and REDIS_POOL_SIZE (%d), got %d", c.RedisPoolSize, c.RedisMinIdleConns)
+	}
+	if c.RedisSlowCommandThresholdMs < 1 {
+		return fmt.Errorf("REDIS_SLOW_COMMAND_THRESHOLD_MS must be at least 1, got %d", c.RedisSlowCommandThresholdMs)
+	}
+
+	if c.ReconcileIntervalSeconds < 1 {
+		return fmt.Errorf("RECONCILE_INTERVAL_SECONDS must be at least 1, got %d", c.ReconcileIntervalSeconds)
+	}
+	if c.LogBodyMaxBytes < 0 {
+		return fmt.Errorf("LOG_BODY_MAX_BYTES must not be negative, got %d", c.LogBodyMaxBytes)
+	}
+
+	// Checks that older deployments may trip are only enforced in strict mode
+	if c.StrictConfig {
+		if c.LogFormat != "json" && c.LogFormat != "console" {
+			return fmt.Errorf("invalid log format: %s (must be json/console)", c.LogFormat)
+		}
+		if u, err := url.Parse(c.RedisURL); err != nil || (u.Scheme != "redis" && u.Scheme != "rediss") {
+			return fmt.Errorf("REDIS_URL must be a redis:// or rediss:// URL")
+		}
+	}
+
+	// Diagnostics must never be exposed on the API port
+	if c.DebugEndpointsEnabled && c.DebugPort == c.ServerPort {
+		return fmt.Errorf("DEBUG_PORT must differ from SERVER_PORT (%s)", c.ServerPort)
+	}
+
+	if c.MetricsEnabled {
+		if c.MetricsPort == c.ServerPort {
+			return fmt.Errorf("METRICS_PORT must differ from SERVER_PORT (%s)", c.ServerPort)
+		}
+		if c.DebugEndpointsEnabled && c.MetricsPort == c.DebugPort {
+			return fmt.Errorf("METRICS_PORT must differ from DEBUG_PORT (%s)", c.DebugPort)
+		}
+	}
+
 	return nil
 }
 
@@ -84,19 +228,63 @@ func (c *Config) GetServerAddress() string {
 	return c.ServerHost + ":" + c.ServerPort
 }
 
+// GetDebugAddress returns the address of the diagnostics server
+// It binds DEBUG_HOST, loopback by default, because the endpoints have no authentication
+func (c *Config) GetDebugAddress() string {
+	return c.DebugHost + ":" + c.DebugPort
+}
+
+// GetMetricsAddress returns the address of the metrics server
+func (c *Config) GetMetricsAddress() string {
+	return c.ServerHost + ":" + c.MetricsPort
+}
+
+// hostname returns the machine hostname, which matches the pod name inside Kubernetes
+func hostname() string {
+	name, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+// redactURL replaces the password in a connection URL
+// Unparsable values may still hold a password, so they are replaced entirely
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "<unparsable>"
+	}
+	return u.Redacted()
+}
+
+// envLoader reads environment variables and records values that fail to parse
+type envLoader struct {
+	invalid []string
+}
+
 // getEnv retrieves an environment variable or returns a default value
-func getEnv(key, defaultVal string) string {
+func (l *envLoader) getEnv(key, defaultVal string) string {
 	if val := os.Getenv(key); val != "" {
 		return val
 	}
 	return defaultVal
 }
 
+// lookupEnv retrieves an environment variable that may be set to an empty string
+func (l *envLoader) lookupEnv(key, defaultVal string) string {
+	if val, ok := os.LookupEnv(key); ok {
+		return val
+	}
+	return defaultVal
+}
+
 // getEnvBool retrieves a boolean environment variable or returns a default value
-func getEnvBool(key string, defaultVal bool) bool {
+func (l *envLoader) getEnvBool(key string, defaultVal bool) bool {
 	if val := os.Getenv(key); val != "" {
 		b, err := strconv.ParseBool(val)
 		if err != nil {
+			l.invalid = append(l.invalid, fmt.Sprintf("%s=%q is not a boolean", key, val))
 			return defaultVal
 		}
 		return b
@@ -105,13 +293,28 @@ func getEnvBool(key string, defaultVal bool) bool {
 }
 
 // getEnvInt retrieves an integer environment variable or returns a default value
-func getEnvInt(key string, defaultVal int) int {
+func (l *envLoader) getEnvInt(key string, defaultVal int) int {
 	if val := os.Getenv(key); val != "" {
 		i, err := strconv.Atoi(val)
 		if err != nil {
+			l.invalid = append(l.invalid, fmt.Sprintf("%s=%q is not an integer", key, val))
 			return defaultVal
 		}
 		return i
 	}
 	return defaultVal
 }
+
+// getEnvSlice retrieves a comma-separated environment variable or returns a default value
+func (l *envLoader) getEnvSlice(key string, defaultVal []string) []string {
+	if val := os.Getenv(key); val != "" {
+		var out []string
+		for _, item := range strings.Split(val, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				out = append(out, item)
+			}
+		}
+		return out
+	}
+	return defaultVal
+}
@@ -2,53 +2,119 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/redis/go-redis/v9"
 )
 
+// ErrKeyNotFound is returned when a requested key does not exist
+var ErrKeyNotFound = errors.New("redis: key not found")
+
+// Options configures the Redis connection pool; zero values keep the defaults
+type Options struct {
+	PoolSize     int
+	MinIdleConns int
+
+	// SlowCommandThreshold is the duration above which commands are logged and counted as slow
+	SlowCommandThreshold time.Duration
+
+	// KeyPrefix is kept when slow command keys are logged; the rest of the key is dropped
+	KeyPrefix string
+}
+
 // Client wraps the Redis client
 type Client struct {
 	client *redis.Client
 }
 
-// NewClient creates a new Redis client
-func NewClient(url string) (*Client, error) {
-	// TODO: Implement Redis client initialization
-	// 1. Parse Redis URL
-	// 2. Create redis.Options
-	// 3. Initialize redis.Client
-	// 4. Ping to verify connection
+// NewClient creates a new Redis client and verifies the connection
+func NewClient(ctx context.Context, url string, opts Options) (*Client, error) {
+	c, err := Open(url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.Ping(ctx); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// Open creates a new Redis client without contacting Redis
+// Connections are made on first use, so callers that can run without Redis start even while it is down
+func Open(url string, opts Options) (*Client, error) {
+	// The URL may carry a password and parse errors quote it, so neither is included in errors
+	redisOpts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, errors.New("failed to parse Redis URL: expected redis://[:password@]host[:port][/db]")
+	}
+	if opts.PoolSize > 0 {
+		redisOpts.PoolSize = opts.PoolSize
+	}
+	if opts.MinIdleConns > 0 {
+		redisOpts.MinIdleConns = opts.MinIdleConns
+	}
+
+	threshold := opts.SlowCommandThreshold
+	if threshold <= 0 {
+		threshold = DefaultSlowCommandThreshold
+	}
+
+	c := &Client{client: redis.NewClient(redisOpts)}
+	c.client.AddHook(&slowCommandHook{
+		threshold: threshold,
+		keyPrefix: opts.KeyPrefix,
+		counter:   metrics.slowCommands,
+	})
 
-	return nil, fmt.Errorf("not implemented: create Redis client with URL %s", url)
+	metrics.add(c.client)
+	return c, nil
 }
 
 // Ping checks if Redis is reachable
 func (c *Client) Ping(ctx context.Context) error {
-	// TODO: Implement ping
-	return fmt.Errorf("not implemented: ping Redis")
+	if err := c.client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("failed to ping Redis: %w", err)
+	}
+	return nil
 }
 
 // Close closes the Redis connection
 func (c *Client) Close() error {
-	// TODO: Implement close
-	return fmt.Errorf("not implemented: close Redis connection")
+	metrics.remove(c.client)
+	if err := c.client.Close(); err != nil {
+		return fmt.Errorf("failed to close Redis connection: %w", err)
+	}
+	return nil
 }
 
-// Get retrieves a value by key
+// Get retrieves a value by key, returning ErrKeyNotFound if it does not exist
 func (c *Client) Get(ctx context.Context, key string) (string, error) {
-	// TODO: Implement get
-	return "", fmt.Errorf("not implemented: get key %s", key)
+	val, err := c.client.Get(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", fmt.Errorf("get key %s: %w", key, ErrKeyNotFound)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get key %s: %w", key, err)
+	}
+	return val, nil
 }
 
-// Set sets a value by key
-func (c *Client) Set(ctx context.Context, key string, value interface{}) error {
-	// TODO: Implement set
-	return fmt.Errorf("not implemented: set key %s", key)
+// Set sets a value by key; a ttl of 0 means the key never expires
+func (c *Client) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
+	if err := c.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set key %s: %w", key, err)
+	}
+	return nil
 }
 
-// Delete deletes a key
+// Delete deletes a key; deleting a missing key is not an error
 func (c *Client) Delete(ctx context.Context, key string) error {
-	// TODO: Implement delete
-	return fmt.Errorf("not implemented: delete key %s", key)
+	if err := c.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("failed to delete key %s: %w", key, err)
+	}
+	return nil
 }
@@ -0,0 +1,132 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient starts a miniredis server and connects a Client to it
+func newTestClient(t *testing.T) (*Client, *miniredis.Miniredis) {
+	t.Helper()
+
+	mr := miniredis.RunT(t)
+	client, err := NewClient(context.Background(), "redis://"+mr.Addr()+"/0", Options{PoolSize: 4, MinIdleConns: 1})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+	return client, mr
+}
+
+func TestNewClient(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	tests := []struct {
+		name        string
+		url         string
+		expectError bool
+		errorMsg    string
+	}{
+		{name: "valid", url: "redis://" + mr.Addr() + "/0"},
+		{name: "invalid scheme", url: "http://" + mr.Addr(), expectError: true, errorMsg: "parse Redis URL"},
+		{name: "unreachable", url: "redis://127.0.0.1:1/0", expectError: true, errorMsg: "ping Redis"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := NewClient(context.Background(), tt.url, Options{})
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, client.Close())
+		})
+	}
+}
+
+func TestOpenConnectsLazily(t *testing.T) {
+	client, err := Open("redis://127.0.0.1:1/0", Options{})
+	require.NoError(t, err, "Open must not contact Redis")
+	t.Cleanup(func() { _ = client.Close() })
+
+	assert.ErrorContains(t, client.Ping(context.Background()), "ping Redis")
+}
+
+func TestNewClientRedactsPassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		url      string
+		errorMsg string
+	}{
+		{name: "unreachable", url: "redis://:s3cret@127.0.0.1:1/0", errorMsg: "ping Redis"},
+		{name: "unparsable", url: "redis://:s3cr%zzet@127.0.0.1:1/0", errorMsg: "parse Redis URL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewClient(context.Background(), tt.url, Options{})
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorMsg)
+			assert.NotContains(t, err.Error(), "s3cr")
+		})
+	}
+}
+
+func TestClientPing(t *testing.T) {
+	client, mr := newTestClient(t)
+
+	require.NoError(t, client.Ping(context.Background()))
+
+	mr.Close()
+	assert.Error(t, client.Ping(context.Background()))
+}
+
+func TestClientGetSet(t *testing.T) {
+	client, mr := newTestClient(t)
+	ctx := context.Background()
+
+	_, err := client.Get(ctx, "missing")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+
+	require.NoError(t, client.Set(ctx, "persistent", "a", 0))
+	require.NoError(t, client.Set(ctx, "expiring", 42, time.Minute))
+
+	val, err := client.Get(ctx, "expiring")
+	require.NoError(t, err)
+	assert.Equal(t, "42", val)
+	assert.Equal(t, time.Minute, mr.TTL("expiring"))
+	assert.Zero(t, mr.TTL("persistent"))
+
+	mr.FastForward(time.Minute)
+	_, err = client.Get(ctx, "expiring")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestClientDelete(t *testing.T) {
+	client, mr := newTestClient(t)
+	ctx := context.Background()
+
+	require.NoError(t, client.Set(ctx, "key", "value", 0))
+	require.NoError(t, client.Delete(ctx, "key"))
+	assert.False(t, mr.Exists("key"))
+
+	assert.NoError(t, client.Delete(ctx, "key"), "deleting a missing key is not an error")
+}
+
+func TestClientErrorsWhenUnavailable(t *testing.T) {
+	client, mr := newTestClient(t)
+	ctx := context.Background()
+	mr.Close()
+
+	_, err := client.Get(ctx, "key")
+	assert.ErrorContains(t, err, "failed to get key")
+	assert.NotErrorIs(t, err, ErrKeyNotFound)
+	assert.ErrorContains(t, client.Set(ctx, "key", "value", 0), "failed to set key")
+	assert.ErrorContains(t, client.Delete(ctx, "key"), "failed to delete key")
+}
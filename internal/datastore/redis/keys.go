@@ -0,0 +1,36 @@
+package redis
+
+import "fmt"
+
+// DefaultKeyPrefix is the namespace applied to every key when none is configured
+const DefaultKeyPrefix = "voice-orchestrator:"
+
+// KeyBuilder builds Redis keys under a configurable prefix so that several
+// orchestrator environments can share a single Redis instance
+type KeyBuilder struct {
+	prefix string
+}
+
+// NewKeyBuilder creates a new KeyBuilder with the given prefix
+func NewKeyBuilder(prefix string) *KeyBuilder {
+	return &KeyBuilder{
+		prefix: prefix,
+	}
+}
+
+// Prefix returns the prefix applied to every key
+func (k *KeyBuilder) Prefix() string {
+	return k.prefix
+}
+
+// MerchantPodCount returns the key holding a merchant's pod count
+// Key format: {prefix}merchant:{merchant_id}:pod_count
+func (k *KeyBuilder) MerchantPodCount(merchantID string) string {
+	return fmt.Sprintf("%smerchant:%s:pod_count", k.prefix, merchantID)
+}
+
+// ActivePods returns the key of the set holding active pod names
+// Key format: {prefix}pods:active (set)
+func (k *KeyBuilder) ActivePods() string {
+	return k.prefix + "pods:active"
+}
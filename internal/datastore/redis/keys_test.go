@@ -0,0 +1,84 @@
+package redis
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyBuilder(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefix      string
+		podCountKey string
+		activeKey   string
+	}{
+		{
+			name:        "default prefix",
+			prefix:      DefaultKeyPrefix,
+			podCountKey: "voice-orchestrator:merchant:merchant-123:pod_count",
+			activeKey:   "voice-orchestrator:pods:active",
+		},
+		{
+			name:        "custom prefix",
+			prefix:      "staging:",
+			podCountKey: "staging:merchant:merchant-123:pod_count",
+			activeKey:   "staging:pods:active",
+		},
+		{
+			name:        "empty prefix",
+			prefix:      "",
+			podCountKey: "merchant:merchant-123:pod_count",
+			activeKey:   "pods:active",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := NewKeyBuilder(tt.prefix)
+
+			assert.Equal(t, tt.prefix, keys.Prefix())
+			assert.Equal(t, tt.podCountKey, keys.MerchantPodCount("merchant-123"))
+			assert.Equal(t, tt.activeKey, keys.ActivePods())
+		})
+	}
+}
+
+// TestNoHardcodedKeys guards against code paths that build keys without the KeyBuilder
+func TestNoHardcodedKeys(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	require.NoError(t, err)
+
+	fragments := []string{"merchant:", "pods:active", DefaultKeyPrefix}
+
+	for _, file := range files {
+		if file == "keys.go" || strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+		require.NoError(t, err)
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			lit, ok := n.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			value, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				return true
+			}
+			for _, fragment := range fragments {
+				assert.NotContains(t, value, fragment, "%s builds a key without the KeyBuilder", file)
+			}
+			return true
+		})
+	}
+}
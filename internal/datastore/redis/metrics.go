@@ -0,0 +1,189 @@
+package redis
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// DefaultSlowCommandThreshold is how long a command may take before it is logged as slow
+const DefaultSlowCommandThreshold = 50 * time.Millisecond
+
+// metrics is shared by every client in the process, so it can be registered once
+var metrics = newPoolMetrics()
+
+// Collector returns the pool and slow command metrics of all clients in the process
+// Register it once, e.g. in main; clients are added by NewClient and removed by Close
+func Collector() prometheus.Collector {
+	return metrics
+}
+
+// poolMetrics exports the connection pool state summed over all open clients and counts slow commands
+// Pool stats are read from go-redis on every scrape, so they are never stale
+type poolMetrics struct {
+	mu      sync.Mutex
+	clients map[*redis.Client]struct{}
+
+	hits       *prometheus.Desc
+	misses     *prometheus.Desc
+	timeouts   *prometheus.Desc
+	totalConns *prometheus.Desc
+	idleConns  *prometheus.Desc
+	staleConns *prometheus.Desc
+
+	slowCommands *prometheus.CounterVec
+}
+
+// newPoolMetrics creates an empty collector
+func newPoolMetrics() *poolMetrics {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName("voice_orchestrator", "redis_pool", name), help, nil, nil)
+	}
+
+	return &poolMetrics{
+		clients:    make(map[*redis.Client]struct{}),
+		hits:       desc("hits_total", "Times a free connection was found in the pool"),
+		misses:     desc("misses_total", "Times a free connection was not found in the pool"),
+		timeouts:   desc("timeouts_total", "Times waiting for a connection timed out"),
+		totalConns: desc("connections", "Connections currently in the pool"),
+		idleConns:  desc("idle_connections", "Idle connections currently in the pool"),
+		staleConns: desc("stale_connections_total", "Stale connections removed from the pool"),
+		slowCommands: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "voice_orchestrator",
+			Name:      "redis_slow_commands_total",
+			Help:      "Redis commands slower than the configured threshold",
+		}, []string{"command"}),
+	}
+}
+
+// add starts reporting the pool stats of client
+func (m *poolMetrics) add(client *redis.Client) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.clients[client] = struct{}{}
+}
+
+// remove stops reporting the pool stats of client
+func (m *poolMetrics) remove(client *redis.Client) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.clients, client)
+}
+
+// Describe implements prometheus.Collector
+func (m *poolMetrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.hits
+	ch <- m.misses
+	ch <- m.timeouts
+	ch <- m.totalConns
+	ch <- m.idleConns
+	ch <- m.staleConns
+	m.slowCommands.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (m *poolMetrics) Collect(ch chan<- prometheus.Metric) {
+	var total redis.PoolStats
+	m.mu.Lock()
+	for client := range m.clients {
+		stats := client.PoolStats()
+		total.Hits += stats.Hits
+		total.Misses += stats.Misses
+		total.Timeouts += stats.Timeouts
+		total.TotalConns += stats.TotalConns
+		total.IdleConns += stats.IdleConns
+		total.StaleConns += stats.StaleConns
+	}
+	m.mu.Unlock()
+
+	ch <- prometheus.MustNewConstMetric(m.hits, prometheus.CounterValue, float64(total.Hits))
+	ch <- prometheus.MustNewConstMetric(m.misses, prometheus.CounterValue, float64(total.Misses))
+	ch <- prometheus.MustNewConstMetric(m.timeouts, prometheus.CounterValue, float64(total.Timeouts))
+	ch <- prometheus.MustNewConstMetric(m.totalConns, prometheus.GaugeValue, float64(total.TotalConns))
+	ch <- prometheus.MustNewConstMetric(m.idleConns, prometheus.GaugeValue, float64(total.IdleConns))
+	ch <- prometheus.MustNewConstMetric(m.staleConns, prometheus.CounterValue, float64(total.StaleConns))
+	m.slowCommands.Collect(ch)
+}
+
+// slowCommandHook logs and counts commands that take longer than threshold
+type slowCommandHook struct {
+	threshold time.Duration
+	keyPrefix string
+	counter   *prometheus.CounterVec
+}
+
+// DialHook implements redis.Hook
+func (h *slowCommandHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+// ProcessHook implements redis.Hook
+func (h *slowCommandHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmd)
+		h.observe(cmd.Name(), h.redactKey(cmd), 1, time.Since(start))
+		return err
+	}
+}
+
+// ProcessPipelineHook implements redis.Hook
+func (h *slowCommandHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		start := time.Now()
+		err := next(ctx, cmds)
+		keyPrefix := ""
+		if len(cmds) > 0 {
+			keyPrefix = h.redactKey(cmds[0])
+		}
+		h.observe("pipeline", keyPrefix, len(cmds), time.Since(start))
+		return err
+	}
+}
+
+// observe records a command if it exceeded the threshold
+func (h *slowCommandHook) observe(command, keyPrefix string, size int, elapsed time.Duration) {
+	if elapsed < h.threshold {
+		return
+	}
+
+	h.counter.WithLabelValues(command).Inc()
+
+	// Clients may be used before the logger is initialized, e.g. in tests
+	if logger.Log != nil {
+		logger.Warn("Slow Redis command",
+			zap.String("command", command),
+			zap.String("key_prefix", keyPrefix),
+			zap.Int("commands", size),
+			zap.Duration("duration", elapsed),
+		)
+	}
+}
+
+// redactKey returns the configured prefix plus the first key segment, e.g. "voice-orchestrator:merchant:"
+// Full keys carry merchant IDs and are never logged
+func (h *slowCommandHook) redactKey(cmd redis.Cmder) string {
+	args := cmd.Args()
+	if len(args) < 2 {
+		return ""
+	}
+	key, ok := args[1].(string)
+	if !ok || !strings.HasPrefix(key, h.keyPrefix) {
+		return ""
+	}
+
+	rest := strings.TrimPrefix(key, h.keyPrefix)
+	if i := strings.IndexByte(rest, ':'); i >= 0 {
+		return h.keyPrefix + rest[:i+1]
+	}
+	return h.keyPrefix + rest
+}
@@ -0,0 +1,151 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestClientMetricsExportPoolStats(t *testing.T) {
+	mr := miniredis.RunT(t)
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(Collector())
+
+	client, err := NewClient(context.Background(), "redis://"+mr.Addr()+"/0", Options{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	require.NoError(t, client.Set(context.Background(), "k", "v", 0))
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	names := make(map[string]bool)
+	for _, mf := range families {
+		names[mf.GetName()] = true
+	}
+	for _, name := range []string{
+		"voice_orchestrator_redis_pool_hits_total",
+		"voice_orchestrator_redis_pool_misses_total",
+		"voice_orchestrator_redis_pool_timeouts_total",
+		"voice_orchestrator_redis_pool_connections",
+		"voice_orchestrator_redis_pool_idle_connections",
+		"voice_orchestrator_redis_pool_stale_connections_total",
+	} {
+		assert.True(t, names[name], "missing metric %s", name)
+	}
+}
+
+func TestClientsShareOneCollector(t *testing.T) {
+	mr := miniredis.RunT(t)
+	m := newPoolMetrics()
+
+	var clients []*redis.Client
+	for i := 0; i < 2; i++ {
+		client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+		t.Cleanup(func() { _ = client.Close() })
+		require.NoError(t, client.Ping(context.Background()).Err())
+		m.add(client)
+		clients = append(clients, client)
+	}
+
+	reg := prometheus.NewRegistry()
+	require.NoError(t, reg.Register(m))
+	assert.Equal(t, float64(2), gaugeValue(t, reg, "voice_orchestrator_redis_pool_connections"))
+
+	m.remove(clients[0])
+	assert.Equal(t, float64(1), gaugeValue(t, reg, "voice_orchestrator_redis_pool_connections"))
+}
+
+func TestNewClientTwiceInOneProcess(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	for i := 0; i < 2; i++ {
+		client, err := NewClient(context.Background(), "redis://"+mr.Addr()+"/0", Options{})
+		require.NoError(t, err)
+		require.NoError(t, client.Close())
+	}
+}
+
+// gaugeValue gathers reg and returns the value of the unlabelled gauge name
+func gaugeValue(t *testing.T, reg *prometheus.Registry, name string) float64 {
+	t.Helper()
+
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	for _, mf := range families {
+		if mf.GetName() == name {
+			return mf.GetMetric()[0].GetGauge().GetValue()
+		}
+	}
+	t.Fatalf("metric %s not found", name)
+	return 0
+}
+
+func TestSlowCommandsAreLoggedWithoutFullKeys(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger.Log = zap.New(core)
+
+	mr := miniredis.RunT(t)
+	before := testutil.ToFloat64(metrics.slowCommands.WithLabelValues("set"))
+	client, err := NewClient(context.Background(), "redis://"+mr.Addr()+"/0", Options{
+		SlowCommandThreshold: time.Nanosecond,
+		KeyPrefix:            "test:",
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = client.Close() })
+
+	repo := NewRepository(client, NewKeyBuilder("test:"))
+	require.NoError(t, repo.SetMerchantPodCount(context.Background(), "merchant-secret", 3))
+
+	entries := logs.FilterMessage("Slow Redis command").FilterField(zap.String("command", "set")).All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "test:merchant:", entries[0].ContextMap()["key_prefix"])
+	for _, entry := range logs.All() {
+		assert.NotContains(t, entry.ContextMap()["key_prefix"], "merchant-secret")
+	}
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.slowCommands.WithLabelValues("set"))-before)
+}
+
+func TestSlowCommandHookIgnoresFastCommands(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	logger.Log = zap.New(core)
+
+	hook := &slowCommandHook{threshold: time.Hour, counter: newPoolMetrics().slowCommands}
+	hook.observe("get", "", 1, time.Millisecond)
+
+	assert.Zero(t, logs.Len())
+	assert.Equal(t, float64(0), testutil.ToFloat64(hook.counter.WithLabelValues("get")))
+}
+
+func TestRedactKey(t *testing.T) {
+	hook := &slowCommandHook{keyPrefix: "voice-orchestrator:"}
+
+	tests := []struct {
+		name     string
+		cmd      redis.Cmder
+		expected string
+	}{
+		{name: "merchant key", cmd: redis.NewCmd(context.Background(), "get", "voice-orchestrator:merchant:m-1:pod_count"), expected: "voice-orchestrator:merchant:"},
+		{name: "single segment", cmd: redis.NewCmd(context.Background(), "smembers", "voice-orchestrator:pods"), expected: "voice-orchestrator:pods"},
+		{name: "foreign prefix", cmd: redis.NewCmd(context.Background(), "get", "other:merchant:m-1"), expected: ""},
+		{name: "no key", cmd: redis.NewCmd(context.Background(), "ping"), expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, hook.redactKey(tt.cmd))
+		})
+	}
+}
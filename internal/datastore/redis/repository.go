@@ -3,51 +3,76 @@ package redis
 import (
 	"context"
 	"fmt"
+	"strconv"
 )
 
 // Repository provides Redis operations for the application
 type Repository struct {
 	client *Client
+	keys   *KeyBuilder
 }
 
 // NewRepository creates a new Redis repository
-func NewRepository(client *Client) *Repository {
+func NewRepository(client *Client, keys *KeyBuilder) *Repository {
 	return &Repository{
 		client: client,
+		keys:   keys,
 	}
 }
 
-// GetMerchantPodCount retrieves the pod count for a merchant
+// GetMerchantPodCount retrieves the pod count for a merchant, returning ErrKeyNotFound if it was never synced
 func (r *Repository) GetMerchantPodCount(ctx context.Context, merchantID string) (int, error) {
-	// TODO: Implement get merchant pod count
-	// Key format: merchant:{merchant_id}:pod_count
-	return 0, fmt.Errorf("not implemented: get pod count for merchant %s", merchantID)
+	val, err := r.client.Get(ctx, r.keys.MerchantPodCount(merchantID))
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf("invalid pod count %q for merchant %s: %w", val, merchantID, err)
+	}
+	return count, nil
 }
 
 // SetMerchantPodCount sets the pod count for a merchant
 func (r *Repository) SetMerchantPodCount(ctx context.Context, merchantID string, count int) error {
-	// TODO: Implement set merchant pod count
-	// Key format: merchant:{merchant_id}:pod_count
-	return fmt.Errorf("not implemented: set pod count for merchant %s to %d", merchantID, count)
+	return r.client.Set(ctx, r.keys.MerchantPodCount(merchantID), count, 0)
+}
+
+// SetMerchantPodCounts sets the pod counts for several merchants in a single round trip
+func (r *Repository) SetMerchantPodCounts(ctx context.Context, counts map[string]int) error {
+	pipe := r.client.client.Pipeline()
+	for merchantID, count := range counts {
+		pipe.Set(ctx, r.keys.MerchantPodCount(merchantID), count, 0)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set pod counts for %d merchants: %w", len(counts), err)
+	}
+	return nil
 }
 
 // GetActivePods retrieves the list of active pod names
 func (r *Repository) GetActivePods(ctx context.Context) ([]string, error) {
-	// TODO: Implement get active pods
-	// Key format: voice-orchestrator:pods:active (set)
-	return nil, fmt.Errorf("not implemented: get active pods")
+	pods, err := r.client.client.SMembers(ctx, r.keys.ActivePods()).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active pods: %w", err)
+	}
+	return pods, nil
 }
 
 // AddActivePod adds a pod to the active pods set
 func (r *Repository) AddActivePod(ctx context.Context, podName string) error {
-	// TODO: Implement add active pod
-	// Key format: voice-orchestrator:pods:active (set)
-	return fmt.Errorf("not implemented: add active pod %s", podName)
+	if err := r.client.client.SAdd(ctx, r.keys.ActivePods(), podName).Err(); err != nil {
+		return fmt.Errorf("failed to add active pod %s: %w", podName, err)
+	}
+	return nil
 }
 
 // RemoveActivePod removes a pod from the active pods set
 func (r *Repository) RemoveActivePod(ctx context.Context, podName string) error {
-	// TODO: Implement remove active pod
-	// Key format: voice-orchestrator:pods:active (set)
-	return fmt.Errorf("not implemented: remove active pod %s", podName)
+	if err := r.client.client.SRem(ctx, r.keys.ActivePods(), podName).Err(); err != nil {
+		return fmt.Errorf("failed to remove active pod %s: %w", podName, err)
+	}
+	return nil
 }
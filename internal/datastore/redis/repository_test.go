@@ -0,0 +1,87 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryMerchantPodCount(t *testing.T) {
+	client, mr := newTestClient(t)
+	keys := NewKeyBuilder("test:")
+	repo := NewRepository(client, keys)
+	ctx := context.Background()
+
+	_, err := repo.GetMerchantPodCount(ctx, "merchant-123")
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+
+	require.NoError(t, repo.SetMerchantPodCount(ctx, "merchant-123", 5))
+	count, err := repo.GetMerchantPodCount(ctx, "merchant-123")
+	require.NoError(t, err)
+	assert.Equal(t, 5, count)
+
+	stored, err := mr.Get(keys.MerchantPodCount("merchant-123"))
+	require.NoError(t, err)
+	assert.Equal(t, "5", stored)
+
+	require.NoError(t, mr.Set(keys.MerchantPodCount("merchant-bad"), "five"))
+	_, err = repo.GetMerchantPodCount(ctx, "merchant-bad")
+	assert.ErrorContains(t, err, "invalid pod count")
+}
+
+func TestRepositorySetMerchantPodCounts(t *testing.T) {
+	client, _ := newTestClient(t)
+	repo := NewRepository(client, NewKeyBuilder(DefaultKeyPrefix))
+	ctx := context.Background()
+
+	counts := map[string]int{"merchant-a": 1, "merchant-b": 0, "merchant-c": 12}
+	require.NoError(t, repo.SetMerchantPodCounts(ctx, counts))
+
+	for merchantID, want := range counts {
+		got, err := repo.GetMerchantPodCount(ctx, merchantID)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, merchantID)
+	}
+}
+
+func TestRepositoryActivePods(t *testing.T) {
+	client, mr := newTestClient(t)
+	keys := NewKeyBuilder("test:")
+	repo := NewRepository(client, keys)
+	ctx := context.Background()
+
+	pods, err := repo.GetActivePods(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pods)
+
+	require.NoError(t, repo.AddActivePod(ctx, "pod-a"))
+	require.NoError(t, repo.AddActivePod(ctx, "pod-b"))
+	require.NoError(t, repo.AddActivePod(ctx, "pod-a"))
+
+	pods, err = repo.GetActivePods(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"pod-a", "pod-b"}, pods)
+
+	require.NoError(t, repo.RemoveActivePod(ctx, "pod-a"))
+	require.NoError(t, repo.RemoveActivePod(ctx, "pod-missing"))
+
+	members, err := mr.Members(keys.ActivePods())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pod-b"}, members)
+}
+
+func TestRepositoryErrorsWhenUnavailable(t *testing.T) {
+	client, mr := newTestClient(t)
+	repo := NewRepository(client, NewKeyBuilder(DefaultKeyPrefix))
+	ctx := context.Background()
+	mr.Close()
+
+	assert.Error(t, repo.SetMerchantPodCount(ctx, "merchant-123", 1))
+	assert.ErrorContains(t, repo.SetMerchantPodCounts(ctx, map[string]int{"merchant-123": 1}), "1 merchants")
+	_, err := repo.GetActivePods(ctx)
+	assert.ErrorContains(t, err, "failed to get active pods")
+	assert.ErrorContains(t, repo.AddActivePod(ctx, "pod-a"), "pod-a")
+	assert.ErrorContains(t, repo.RemoveActivePod(ctx, "pod-a"), "pod-a")
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "errors"
+
+// Sentinel errors returned by the datastores and services
+var (
+	ErrMerchantNotFound = errors.New("merchant not found")
+	ErrPodNotFound      = errors.New("pod not found")
+	ErrNoPodsAvailable  = errors.New("no pods available")
+	ErrQuotaExceeded    = errors.New("pod quota exceeded")
+	ErrNotImplemented   = errors.New("not implemented")
+)
@@ -0,0 +1,67 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxMerchantIDLength bounds merchant IDs, which end up in Redis keys and log lines
+	MaxMerchantIDLength = 64
+
+	// MaxMerchantNameLength bounds human-readable merchant names
+	MaxMerchantNameLength = 255
+)
+
+var merchantIDPattern = regexp.MustCompile(`^[A-Za-z0-9_\-\.]+$`)
+
+// FieldError reports an invalid request field
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+// Error implements the error interface
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Reason)
+}
+
+// MerchantID validates a merchant ID: 1-64 characters of [A-Za-z0-9_-.]
+func MerchantID(field, value string) error {
+	if value == "" {
+		return &FieldError{Field: field, Reason: "must not be empty"}
+	}
+	if len(value) > MaxMerchantIDLength {
+		return &FieldError{Field: field, Reason: fmt.Sprintf("must be at most %d characters", MaxMerchantIDLength)}
+	}
+	if !merchantIDPattern.MatchString(value) {
+		return &FieldError{Field: field, Reason: "may only contain letters, digits, '_', '-' and '.'"}
+	}
+	return nil
+}
+
+// MerchantName validates a merchant name: non-blank UTF-8 without control characters
+func MerchantName(field, value string) error {
+	if !utf8.ValidString(value) {
+		return &FieldError{Field: field, Reason: "must be valid UTF-8"}
+	}
+	if utf8.RuneCountInString(value) > MaxMerchantNameLength {
+		return &FieldError{Field: field, Reason: fmt.Sprintf("must be at most %d characters", MaxMerchantNameLength)}
+	}
+
+	blank := true
+	for _, r := range value {
+		if unicode.IsControl(r) {
+			return &FieldError{Field: field, Reason: "must not contain control characters"}
+		}
+		if !unicode.IsSpace(r) {
+			blank = false
+		}
+	}
+	if blank {
+		return &FieldError{Field: field, Reason: "must not be blank"}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerchantID(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+		errorMsg    string
+	}{
+		{name: "valid", value: "merchant-123"},
+		{name: "valid with dots and underscores", value: "acme.prod_01"},
+		{name: "max length", value: strings.Repeat("a", MaxMerchantIDLength)},
+		{name: "empty", value: "", expectError: true, errorMsg: "must not be empty"},
+		{name: "too long", value: strings.Repeat("a", MaxMerchantIDLength+1), expectError: true, errorMsg: "at most 64"},
+		{name: "key separator", value: "merchant:123", expectError: true, errorMsg: "may only contain"},
+		{name: "glob pattern", value: "merchant-*", expectError: true, errorMsg: "may only contain"},
+		{name: "whitespace", value: "merchant 123", expectError: true, errorMsg: "may only contain"},
+		{name: "newline", value: "merchant\n123", expectError: true, errorMsg: "may only contain"},
+		{name: "path traversal", value: "../admin", expectError: true, errorMsg: "may only contain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := MerchantID("merchant_id", tt.value)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "merchant_id")
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMerchantName(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expectError bool
+		errorMsg    string
+	}{
+		{name: "valid", value: "Acme Corp"},
+		{name: "unicode", value: "Société Générale"},
+		{name: "blank", value: "   ", expectError: true, errorMsg: "must not be blank"},
+		{name: "control character", value: "Acme\x00Corp", expectError: true, errorMsg: "control characters"},
+		{name: "invalid utf8", value: "Acme\xffCorp", expectError: true, errorMsg: "UTF-8"},
+		{name: "too long", value: strings.Repeat("é", MaxMerchantNameLength+1), expectError: true, errorMsg: "at most 255"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := MerchantName("name", tt.value)
+			if tt.expectError {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func FuzzMerchantID(f *testing.F) {
+	for _, seed := range []string{"merchant-123", "", "a:b", strings.Repeat("x", 100), "\x00"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, value string) {
+		if MerchantID("merchant_id", value) != nil {
+			return
+		}
+		// Anything accepted must be safe to embed in a Redis key or log line
+		if len(value) == 0 || len(value) > MaxMerchantIDLength {
+			t.Fatalf("accepted merchant ID of length %d", len(value))
+		}
+		if strings.ContainsAny(value, ": *?[]\n\r\t") {
+			t.Fatalf("accepted unsafe merchant ID %q", value)
+		}
+	})
+}
+
+func FuzzMerchantName(f *testing.F) {
+	for _, seed := range []string{"Acme", " ", "\x7f", "Société"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, value string) {
+		if MerchantName("name", value) != nil {
+			return
+		}
+		if !utf8.ValidString(value) || strings.TrimSpace(value) == "" {
+			t.Fatalf("accepted invalid merchant name %q", value)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Health checks the liveness of the router
+func (c *Client) Health(ctx context.Context) (*HealthResponse, error) {
+	var resp HealthResponse
+	if err := c.do(ctx, http.MethodGet, "/health", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Ready checks the readiness of the router and its dependencies
+func (c *Client) Ready(ctx context.Context) (*ReadyResponse, error) {
+	var resp ReadyResponse
+	if err := c.do(ctx, http.MethodGet, "/ready", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Allocate requests pods for a merchant
+// Allocation is not idempotent, so it is never retried automatically
+func (c *Client) Allocate(ctx context.Context, req AllocateRequest) (*AllocateResponse, error) {
+	var resp AllocateResponse
+	if err := c.do(ctx, http.MethodPost, "/api/v1/allocate", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CreateMerchant creates a new merchant
+func (c *Client) CreateMerchant(ctx context.Context, req CreateMerchantRequest) (*Merchant, error) {
+	var merchant Merchant
+	if err := c.do(ctx, http.MethodPost, "/api/v1/admin/merchants", req, &merchant); err != nil {
+		return nil, err
+	}
+	return &merchant, nil
+}
+
+// GetMerchant retrieves a merchant by ID
+func (c *Client) GetMerchant(ctx context.Context, merchantID string) (*Merchant, error) {
+	var merchant Merchant
+	if err := c.do(ctx, http.MethodGet, merchantPath(merchantID), nil, &merchant); err != nil {
+		return nil, err
+	}
+	return &merchant, nil
+}
+
+// UpdateMerchant updates a merchant
+func (c *Client) UpdateMerchant(ctx context.Context, merchantID string, req UpdateMerchantRequest) (*Merchant, error) {
+	var merchant Merchant
+	if err := c.do(ctx, http.MethodPut, merchantPath(merchantID), req, &merchant); err != nil {
+		return nil, err
+	}
+	return &merchant, nil
+}
+
+// DeleteMerchant deletes a merchant
+func (c *Client) DeleteMerchant(ctx context.Context, merchantID string) error {
+	return c.do(ctx, http.MethodDelete, merchantPath(merchantID), nil, nil)
+}
+
+// merchantPath returns the admin path for a merchant
+func merchantPath(merchantID string) string {
+	return "/api/v1/admin/merchants/" + url.PathEscape(merchantID)
+}
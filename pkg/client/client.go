@@ -0,0 +1,251 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the router address used when no base URL is configured
+	DefaultBaseURL = "http://localhost:8080"
+
+	// DefaultTimeout bounds every request made with the default HTTP client
+	DefaultTimeout = 5 * time.Second
+
+	// DefaultMaxRetries is the number of retries for idempotent requests
+	DefaultMaxRetries = 2
+
+	// DefaultUserAgent identifies the SDK to the router
+	DefaultUserAgent = "voice-orchestrator-go-client"
+)
+
+// Client is a typed client for the voice orchestrator router HTTP API
+type Client struct {
+	baseURL      string
+	httpClient   *http.Client
+	userAgent    string
+	bearerToken  string
+	maxRetries   int
+	retryBackoff time.Duration
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithBaseURL sets the router base URL, e.g. http://router-service:8080
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithHTTPClient sets the underlying HTTP client
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithBearerToken sends the token in the Authorization header of every request
+func WithBearerToken(token string) Option {
+	return func(c *Client) {
+		c.bearerToken = token
+	}
+}
+
+// WithMaxRetries sets how many times idempotent requests are retried
+func WithMaxRetries(maxRetries int) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithRetryBackoff sets the base delay between retries (doubled on each attempt)
+func WithRetryBackoff(backoff time.Duration) Option {
+	return func(c *Client) {
+		c.retryBackoff = backoff
+	}
+}
+
+// New creates a new Client
+func New(opts ...Option) *Client {
+	c := &Client{
+		baseURL:      DefaultBaseURL,
+		httpClient:   &http.Client{Timeout: DefaultTimeout},
+		userAgent:    DefaultUserAgent,
+		maxRetries:   DefaultMaxRetries,
+		retryBackoff: 100 * time.Millisecond,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// APIError is returned when the router responds with an error envelope
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	RequestID  string `json:"request_id,omitempty"`
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("voice-orchestrator: %s (%d): %s [request_id=%s]", e.Code, e.StatusCode, e.Message, e.RequestID)
+	}
+	return fmt.Sprintf("voice-orchestrator: %s (%d): %s", e.Code, e.StatusCode, e.Message)
+}
+
+// IsCode reports whether err is an APIError with the given code
+func IsCode(err error, code string) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
+// do sends a request and decodes the JSON response into out (if non-nil)
+// Only idempotent methods are retried; POST is never retried because the
+// router gives no guarantee that a timed-out POST was not applied
+func (c *Client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		body, err = json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	attempts := 1
+	if isIdempotent(method) {
+		attempts += c.maxRetries
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, c.retryBackoff<<(attempt-1)); err != nil {
+				return err
+			}
+		}
+
+		retry, err := c.attempt(ctx, method, path, body, out)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !retry {
+			break
+		}
+	}
+
+	return lastErr
+}
+
+// attempt performs a single HTTP round trip and reports whether a failure is retryable
+func (c *Client) attempt(ctx context.Context, method, path string, body []byte, out interface{}) (bool, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return false, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		return true, fmt.Errorf("%s %s failed: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return isRetryableStatus(resp.StatusCode), decodeAPIError(resp)
+	}
+
+	if out == nil {
+		return false, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return false, nil
+}
+
+// decodeAPIError reads the router's error envelope from a failed response
+func decodeAPIError(resp *http.Response) error {
+	var envelope struct {
+		Error APIError `json:"error"`
+	}
+
+	apiErr := &envelope.Error
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil || apiErr.Code == "" {
+		apiErr.Code = "UNKNOWN"
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	apiErr.StatusCode = resp.StatusCode
+
+	return apiErr
+}
+
+// isIdempotent reports whether a request with the given method may be safely retried
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryableStatus reports whether a response status indicates a transient failure
+func isRetryableStatus(status int) bool {
+	switch status {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
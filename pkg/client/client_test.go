@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeAPIError writes the router's error envelope
+func writeAPIError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]string{
+			"code":       code,
+			"message":    message,
+			"request_id": "req-123",
+		},
+	})
+}
+
+func newTestClient(srv *httptest.Server, opts ...Option) *Client {
+	opts = append([]Option{WithBaseURL(srv.URL), WithRetryBackoff(time.Millisecond)}, opts...)
+	return New(opts...)
+}
+
+func TestHealth(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/health", r.URL.Path)
+		assert.Equal(t, DefaultUserAgent, r.Header.Get("User-Agent"))
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"status":  "healthy",
+			"service": "voice-orchestrator",
+			"version": "dev",
+		})
+	}))
+	defer srv.Close()
+
+	resp, err := newTestClient(srv).Health(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "healthy", resp.Status)
+	assert.Equal(t, "voice-orchestrator", resp.Service)
+}
+
+func TestAllocateDecodesAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var req AllocateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "merchant-123", req.MerchantID)
+
+		writeAPIError(w, http.StatusNotImplemented, "NOT_IMPLEMENTED", "Pod allocation is not implemented yet")
+	}))
+	defer srv.Close()
+
+	_, err := newTestClient(srv).Allocate(context.Background(), AllocateRequest{MerchantID: "merchant-123", PodCount: 1})
+
+	require.Error(t, err)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotImplemented, apiErr.StatusCode)
+	assert.Equal(t, "NOT_IMPLEMENTED", apiErr.Code)
+	assert.Equal(t, "req-123", apiErr.RequestID)
+	assert.True(t, IsCode(err, "NOT_IMPLEMENTED"))
+}
+
+func TestAllocateIsNeverRetried(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writeAPIError(w, http.StatusServiceUnavailable, "NO_PODS_AVAILABLE", "no pods available")
+	}))
+	defer srv.Close()
+
+	_, err := newTestClient(srv, WithMaxRetries(3)).Allocate(context.Background(), AllocateRequest{MerchantID: "merchant-123", PodCount: 1})
+
+	assert.True(t, IsCode(err, "NO_PODS_AVAILABLE"))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestGetMerchantRetriesTransientFailures(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/admin/merchants/merchant-123", r.URL.Path)
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":                "merchant-123",
+			"name":              "Merchant 123",
+			"desired_pod_count": 4,
+		})
+	}))
+	defer srv.Close()
+
+	merchant, err := newTestClient(srv, WithMaxRetries(2)).GetMerchant(context.Background(), "merchant-123")
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, merchant.DesiredPodCount)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestClientErrorsAreNotRetried(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		writeAPIError(w, http.StatusNotFound, "MERCHANT_NOT_FOUND", "merchant not found")
+	}))
+	defer srv.Close()
+
+	_, err := newTestClient(srv).GetMerchant(context.Background(), "missing")
+
+	assert.True(t, IsCode(err, "MERCHANT_NOT_FOUND"))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestNonEnvelopeErrorResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "404 page not found", http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	_, err := newTestClient(srv).Ready(context.Background())
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "UNKNOWN", apiErr.Code)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+}
+
+func TestBearerTokenAndUserAgent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "dialer/1.0", r.Header.Get("User-Agent"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	err := newTestClient(srv, WithBearerToken("secret"), WithUserAgent("dialer/1.0")).
+		DeleteMerchant(context.Background(), "merchant-123")
+
+	require.NoError(t, err)
+}
+
+func TestContextCancellationStopsRetries(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := newTestClient(srv, WithRetryBackoff(time.Hour)).Health(ctx)
+
+	require.ErrorIs(t, err, context.Canceled)
+}
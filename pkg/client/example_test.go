@@ -0,0 +1,42 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/MonishJuspay/voice-orchestrator/pkg/client"
+)
+
+func ExampleClient_Allocate() {
+	// Stand-in for the router, answering the way the real handler does today
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotImplemented)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"error": map[string]string{
+				"code":    "NOT_IMPLEMENTED",
+				"message": "Pod allocation is not implemented yet",
+			},
+		})
+	}))
+	defer srv.Close()
+
+	c := client.New(
+		client.WithBaseURL(srv.URL),
+		client.WithHTTPClient(&http.Client{Timeout: 2 * time.Second}),
+		client.WithUserAgent("dialer/1.0"),
+	)
+
+	_, err := c.Allocate(context.Background(), client.AllocateRequest{
+		MerchantID: "merchant-123",
+		PodCount:   1,
+	})
+	if client.IsCode(err, "NOT_IMPLEMENTED") {
+		fmt.Println("allocation not available yet")
+	}
+	// Output: allocation not available yet
+}
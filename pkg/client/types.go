@@ -0,0 +1,63 @@
+package client
+
+import "time"
+
+// Wire types mirror internal/domain so that services outside this module can use the client
+
+// Pod is a pod returned by the router
+type Pod struct {
+	Name       string    `json:"name"`
+	Namespace  string    `json:"namespace"`
+	MerchantID string    `json:"merchant_id"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// AllocateRequest is the body of POST /api/v1/allocate
+type AllocateRequest struct {
+	MerchantID string `json:"merchant_id"`
+	PodCount   int    `json:"pod_count"`
+}
+
+// AllocateResponse is returned by POST /api/v1/allocate
+type AllocateResponse struct {
+	MerchantID     string `json:"merchant_id"`
+	RequestedCount int    `json:"requested_count"`
+	AllocatedCount int    `json:"allocated_count"`
+	AvailablePods  []Pod  `json:"available_pods"`
+	Message        string `json:"message"`
+}
+
+// Merchant is a merchant returned by the admin API
+type Merchant struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	DesiredPodCount int       `json:"desired_pod_count"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// CreateMerchantRequest is the body of POST /api/v1/admin/merchants
+type CreateMerchantRequest struct {
+	Name            string `json:"name"`
+	DesiredPodCount int    `json:"desired_pod_count"`
+}
+
+// UpdateMerchantRequest is the body of PUT /api/v1/admin/merchants/:id
+type UpdateMerchantRequest struct {
+	Name            *string `json:"name,omitempty"`
+	DesiredPodCount *int    `json:"desired_pod_count,omitempty"`
+}
+
+// HealthResponse is returned by GET /health
+type HealthResponse struct {
+	Status  string `json:"status"`
+	Service string `json:"service"`
+	Version string `json:"version"`
+}
+
+// ReadyResponse is returned by GET /ready
+type ReadyResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
@@ -0,0 +1,153 @@
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// BuildInfo describes the running binary, injected at build time via ldflags
+type BuildInfo struct {
+	Version   string `json:"version"`
+	CommitSHA string `json:"commit_sha"`
+	BuildTime string `json:"build_time"`
+}
+
+// GCStats is a summary of the garbage collector and heap state
+type GCStats struct {
+	NumGC          uint32    `json:"num_gc"`
+	PauseTotalNs   uint64    `json:"pause_total_ns"`
+	LastGC         time.Time `json:"last_gc"`
+	HeapAllocBytes uint64    `json:"heap_alloc_bytes"`
+	HeapSysBytes   uint64    `json:"heap_sys_bytes"`
+	NextGCBytes    uint64    `json:"next_gc_bytes"`
+}
+
+// Vars is the payload served by /debug/vars
+type Vars struct {
+	Build         BuildInfo `json:"build"`
+	GoVersion     string    `json:"go_version"`
+	Goroutines    int       `json:"goroutines"`
+	UptimeSeconds float64   `json:"uptime_seconds"`
+	GC            GCStats   `json:"gc"`
+}
+
+// NewMux returns a mux serving pprof and /debug/vars when enabled
+// When disabled, the mux has no routes and every request gets a 404
+func NewMux(enabled bool, info BuildInfo) *http.ServeMux {
+	mux := http.NewServeMux()
+	if enabled {
+		Register(mux, info)
+	}
+	return mux
+}
+
+// Register mounts the pprof handlers, /debug/vars and /debug/loglevel on mux
+func Register(mux *http.ServeMux, info BuildInfo) {
+	started := time.Now()
+
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	mux.HandleFunc("/debug/vars", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(collectVars(info, started))
+	})
+
+	mux.HandleFunc("/debug/loglevel", serveLogLevel)
+}
+
+// serveLogLevel reports the log level on GET and temporarily changes it on PUT
+func serveLogLevel(w http.ResponseWriter, r *http.Request) {
+	status := logger.CurrentLevel()
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		var req logger.LevelRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		var err error
+		if status, err = req.Apply(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		logger.Warn("Log level changed",
+			zap.String("level", status.Level),
+			zap.Timep("revert_at", status.RevertAt),
+		)
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+// collectVars samples the runtime state
+func collectVars(info BuildInfo, started time.Time) Vars {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	var lastGC time.Time
+	if mem.LastGC > 0 {
+		lastGC = time.Unix(0, int64(mem.LastGC))
+	}
+
+	return Vars{
+		Build:         info,
+		GoVersion:     runtime.Version(),
+		Goroutines:    runtime.NumGoroutine(),
+		UptimeSeconds: time.Since(started).Seconds(),
+		GC: GCStats{
+			NumGC:          mem.NumGC,
+			PauseTotalNs:   mem.PauseTotalNs,
+			LastGC:         lastGC,
+			HeapAllocBytes: mem.HeapAlloc,
+			HeapSysBytes:   mem.HeapSys,
+			NextGCBytes:    mem.NextGC,
+		},
+	}
+}
+
+// Serve runs the diagnostics server on addr until ctx is cancelled
+// It must never share a port with the public API
+func Serve(ctx context.Context, addr string, info BuildInfo) error {
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           NewMux(true, info),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		logger.Info("Starting diagnostics server", zap.String("address", addr))
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errChan <- fmt.Errorf("diagnostics server failed: %w", err)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	case err := <-errChan:
+		return err
+	}
+}
@@ -0,0 +1,90 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testBuildInfo = BuildInfo{
+	Version:   "v1.2.3",
+	CommitSHA: "abc1234",
+	BuildTime: "2024-01-15_10:30:45",
+}
+
+func TestEndpointsDisabled(t *testing.T) {
+	mux := NewMux(false, testBuildInfo)
+
+	for _, path := range []string{"/debug/vars", "/debug/pprof/", "/debug/pprof/heap", "/debug/loglevel"} {
+		t.Run(path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+			assert.Equal(t, http.StatusNotFound, w.Code)
+		})
+	}
+}
+
+func TestEndpointsEnabled(t *testing.T) {
+	mux := NewMux(true, testBuildInfo)
+
+	for _, path := range []string{"/debug/pprof/", "/debug/pprof/heap", "/debug/pprof/goroutine"} {
+		t.Run(path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
+
+func TestVars(t *testing.T) {
+	mux := NewMux(true, testBuildInfo)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var vars Vars
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &vars))
+	assert.Equal(t, testBuildInfo, vars.Build)
+	assert.NotEmpty(t, vars.GoVersion)
+	assert.Positive(t, vars.Goroutines)
+	assert.GreaterOrEqual(t, vars.UptimeSeconds, 0.0)
+	assert.Positive(t, vars.GC.HeapSysBytes)
+}
+
+func TestLogLevel(t *testing.T) {
+	require.NoError(t, logger.InitLogger("info", "json"))
+	t.Cleanup(func() { require.NoError(t, logger.InitLogger("info", "json")) })
+	mux := NewMux(true, testBuildInfo)
+
+	w := httptest.NewRecorder()
+	body := strings.NewReader(`{"level":"debug","duration":"1m"}`)
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/debug/loglevel", body))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/loglevel", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	var status logger.LevelStatus
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &status))
+	assert.Equal(t, "debug", status.Level)
+	assert.NotNil(t, status.RevertAt)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/debug/loglevel", strings.NewReader(`{"level":"debug"}`)))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/debug/loglevel", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
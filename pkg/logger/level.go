@@ -0,0 +1,138 @@
+package logger
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// MaxLevelOverride bounds how long a temporary log level may stay in effect
+const MaxLevelOverride = 24 * time.Hour
+
+// LevelStatus describes the current log level and when a temporary override ends
+type LevelStatus struct {
+	Level    string     `json:"level"`
+	RevertAt *time.Time `json:"revert_at,omitempty"`
+}
+
+// levelControl owns the atomic level shared by every logger built by InitLogger
+type levelControl struct {
+	mu       sync.Mutex
+	atomic   zap.AtomicLevel
+	base     zapcore.Level
+	timer    *time.Timer
+	revertAt time.Time
+}
+
+var levels = &levelControl{atomic: zap.NewAtomicLevelAt(zapcore.InfoLevel)}
+
+// parseLevel maps a configured level name to a zap level, accepting the same names as LOG_LEVEL
+func parseLevel(level string) (zapcore.Level, error) {
+	switch level {
+	case "debug":
+		return zapcore.DebugLevel, nil
+	case "info":
+		return zapcore.InfoLevel, nil
+	case "warn":
+		return zapcore.WarnLevel, nil
+	case "error":
+		return zapcore.ErrorLevel, nil
+	default:
+		return zapcore.InfoLevel, fmt.Errorf("invalid log level %q (must be debug/info/warn/error)", level)
+	}
+}
+
+// setBase sets the configured level, cancelling any temporary override
+func (l *levelControl) setBase(level zapcore.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stopTimer()
+	l.base = level
+	l.atomic.SetLevel(level)
+}
+
+// override switches to level until d elapses, then restores the configured level
+func (l *levelControl) override(level zapcore.Level, d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stopTimer()
+	l.atomic.SetLevel(level)
+	l.revertAt = time.Now().Add(d)
+
+	var timer *time.Timer
+	timer = time.AfterFunc(d, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		// A newer override replaced this timer after it fired
+		if l.timer != timer {
+			return
+		}
+		l.timer = nil
+		l.atomic.SetLevel(l.base)
+		if Log != nil {
+			Log.Info("Temporary log level expired", zap.String("level", l.base.String()))
+		}
+	})
+	l.timer = timer
+}
+
+// stopTimer cancels a pending revert; callers must hold mu
+func (l *levelControl) stopTimer() {
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+}
+
+// status reports the current level and pending revert
+func (l *levelControl) status() LevelStatus {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	status := LevelStatus{Level: l.atomic.Level().String()}
+	if l.timer != nil {
+		revertAt := l.revertAt
+		status.RevertAt = &revertAt
+	}
+	return status
+}
+
+// SetLevelFor switches the log level for d, after which the configured LOG_LEVEL is restored
+func SetLevelFor(level string, d time.Duration) (LevelStatus, error) {
+	lvl, err := parseLevel(level)
+	if err != nil {
+		return LevelStatus{}, err
+	}
+	if d <= 0 || d > MaxLevelOverride {
+		return LevelStatus{}, fmt.Errorf("duration must be between 0 and %s, got %s", MaxLevelOverride, d)
+	}
+
+	levels.override(lvl, d)
+	return levels.status(), nil
+}
+
+// CurrentLevel returns the active log level and when a temporary override ends
+func CurrentLevel() LevelStatus {
+	return levels.status()
+}
+
+// LevelRequest is the body accepted by the log level endpoints, e.g. {"level":"debug","duration":"10m"}
+type LevelRequest struct {
+	Level    string `json:"level"`
+	Duration string `json:"duration"`
+}
+
+// Apply validates the request and switches the log level accordingly
+func (r LevelRequest) Apply() (LevelStatus, error) {
+	d, err := time.ParseDuration(r.Duration)
+	if err != nil {
+		return LevelStatus{}, fmt.Errorf("invalid duration: %w", err)
+	}
+	return SetLevelFor(r.Level, d)
+}
@@ -0,0 +1,70 @@
+package logger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestSetLevelForRevertsAfterDuration(t *testing.T) {
+	require.NoError(t, InitLogger("info", "json"))
+	assert.False(t, Log.Core().Enabled(zapcore.DebugLevel))
+
+	status, err := SetLevelFor("debug", 50*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, "debug", status.Level)
+	require.NotNil(t, status.RevertAt)
+	assert.WithinDuration(t, time.Now().Add(50*time.Millisecond), *status.RevertAt, 50*time.Millisecond)
+	assert.True(t, Log.Core().Enabled(zapcore.DebugLevel), "the running logger must pick up the new level")
+
+	assert.Eventually(t, func() bool {
+		return CurrentLevel().Level == "info"
+	}, time.Second, 10*time.Millisecond)
+	assert.Nil(t, CurrentLevel().RevertAt)
+	assert.False(t, Log.Core().Enabled(zapcore.DebugLevel))
+}
+
+func TestSetLevelForReplacesPendingOverride(t *testing.T) {
+	require.NoError(t, InitLogger("warn", "json"))
+
+	_, err := SetLevelFor("debug", 20*time.Millisecond)
+	require.NoError(t, err)
+	_, err = SetLevelFor("info", time.Hour)
+	require.NoError(t, err)
+
+	// The first timer must not revert the second override
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, "info", CurrentLevel().Level)
+	assert.NotNil(t, CurrentLevel().RevertAt)
+
+	require.NoError(t, InitLogger("warn", "json"))
+	assert.Equal(t, LevelStatus{Level: "warn"}, CurrentLevel(), "reinitializing cancels the override")
+}
+
+func TestSetLevelForRejectsInvalidInput(t *testing.T) {
+	require.NoError(t, InitLogger("info", "json"))
+
+	tests := []struct {
+		name     string
+		req      LevelRequest
+		errorMsg string
+	}{
+		{name: "unknown level", req: LevelRequest{Level: "trace", Duration: "10m"}, errorMsg: "invalid log level"},
+		{name: "fatal level", req: LevelRequest{Level: "fatal", Duration: "10m"}, errorMsg: "invalid log level"},
+		{name: "missing duration", req: LevelRequest{Level: "debug"}, errorMsg: "invalid duration"},
+		{name: "zero duration", req: LevelRequest{Level: "debug", Duration: "0s"}, errorMsg: "duration must be between"},
+		{name: "too long", req: LevelRequest{Level: "debug", Duration: "25h"}, errorMsg: "duration must be between"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.req.Apply()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorMsg)
+			assert.Equal(t, LevelStatus{Level: "info"}, CurrentLevel())
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package logger
 
 import (
+	"context"
+
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -10,6 +12,28 @@ var (
 	Log *zap.Logger
 )
 
+// Field keys shared by every component so that log lines can be correlated
+const (
+	KeyRequestID  = "request_id"
+	KeyMerchantID = "merchant_id"
+)
+
+// ctxKey is the context key holding a request-scoped logger
+type ctxKey struct{}
+
+// WithFields returns a copy of ctx whose logger carries the given fields in addition to any already present
+func WithFields(ctx context.Context, fields ...zap.Field) context.Context {
+	return context.WithValue(ctx, ctxKey{}, FromContext(ctx).With(fields...))
+}
+
+// FromContext returns the request-scoped logger stored in ctx, or the global logger
+func FromContext(ctx context.Context) *zap.Logger {
+	if l, ok := ctx.Value(ctxKey{}).(*zap.Logger); ok {
+		return l
+	}
+	return Log
+}
+
 // InitLogger initializes the global logger
 func InitLogger(level, format string) error {
 	var config zap.Config
@@ -21,19 +45,10 @@ func InitLogger(level, format string) error {
 		config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	}
 
-	// Set log level
-	switch level {
-	case "debug":
-		config.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
-	case "info":
-		config.Level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
-	case "warn":
-		config.Level = zap.NewAtomicLevelAt(zapcore.WarnLevel)
-	case "error":
-		config.Level = zap.NewAtomicLevelAt(zapcore.ErrorLevel)
-	default:
-		config.Level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
-	}
+	// Unknown levels fall back to info; config validation rejects them earlier
+	lvl, _ := parseLevel(level)
+	levels.setBase(lvl)
+	config.Level = levels.atomic
 
 	var err error
 	Log, err = config.Build()
@@ -0,0 +1,32 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestFromContextFallsBackToGlobal(t *testing.T) {
+	Log = zap.NewNop()
+
+	assert.Same(t, Log, FromContext(context.Background()))
+}
+
+func TestWithFieldsAccumulates(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	Log = zap.New(core)
+
+	ctx := WithFields(context.Background(), zap.String(KeyRequestID, "req-abc"))
+	ctx = WithFields(ctx, zap.String(KeyMerchantID, "merchant-123"))
+	FromContext(ctx).Info("allocated")
+
+	require.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, "req-abc", fields[KeyRequestID])
+	assert.Equal(t, "merchant-123", fields[KeyMerchantID])
+}
@@ -0,0 +1,139 @@
+package metricsserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+// Config configures the metrics server
+type Config struct {
+	// Addr is the listen address, e.g. ":9091"
+	Addr string
+
+	// TLSCertFile and TLSKeyFile enable HTTPS when both are set
+	TLSCertFile string
+	TLSKeyFile  string
+
+	// BasicAuthUsername and BasicAuthPassword protect /metrics when both are set
+	BasicAuthUsername string
+	BasicAuthPassword string
+
+	// Gatherer is scraped on /metrics; defaults to the Prometheus default registry
+	Gatherer prometheus.Gatherer
+}
+
+// Server serves /metrics and /health on a dedicated port
+type Server struct {
+	config     Config
+	httpServer *http.Server
+}
+
+// New validates cfg and builds the metrics server
+func New(cfg Config) (*Server, error) {
+	if (cfg.TLSCertFile == "") != (cfg.TLSKeyFile == "") {
+		return nil, errors.New("metrics TLS requires both a certificate and a key file")
+	}
+	if (cfg.BasicAuthUsername == "") != (cfg.BasicAuthPassword == "") {
+		return nil, errors.New("metrics basic auth requires both a username and a password")
+	}
+	if cfg.Gatherer == nil {
+		cfg.Gatherer = prometheus.DefaultGatherer
+	}
+
+	s := &Server{config: cfg}
+	s.httpServer = &http.Server{
+		Addr:              cfg.Addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	// Load the key pair now so missing or mismatched files fail startup rather than Run
+	if s.TLSEnabled() {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load metrics TLS key pair: %w", err)
+		}
+		s.httpServer.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+	return s, nil
+}
+
+// Handler returns the mux serving /metrics and /health
+func (s *Server) Handler() http.Handler {
+	var metrics http.Handler = promhttp.HandlerFor(s.config.Gatherer, promhttp.HandlerOpts{})
+	if s.config.BasicAuthUsername != "" {
+		metrics = basicAuth(metrics, s.config.BasicAuthUsername, s.config.BasicAuthPassword)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics)
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("ok"))
+	})
+	return mux
+}
+
+// TLSEnabled reports whether the server serves HTTPS
+func (s *Server) TLSEnabled() bool {
+	return s.config.TLSCertFile != ""
+}
+
+// Run serves until ctx is cancelled, then shuts down gracefully
+func (s *Server) Run(ctx context.Context) error {
+	errChan := make(chan error, 1)
+	go func() {
+		logger.Info("Starting metrics server",
+			zap.String("address", s.config.Addr),
+			zap.Bool("tls", s.TLSEnabled()),
+			zap.Bool("basic_auth", s.config.BasicAuthUsername != ""),
+		)
+
+		var err error
+		if s.TLSEnabled() {
+			err = s.httpServer.ListenAndServeTLS("", "")
+		} else {
+			err = s.httpServer.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errChan <- fmt.Errorf("metrics server failed: %w", err)
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return s.httpServer.Shutdown(shutdownCtx)
+	case err := <-errChan:
+		return err
+	}
+}
+
+// basicAuth rejects requests without the expected credentials, comparing in constant time
+func basicAuth(next http.Handler, username, password string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		userMatch := subtle.ConstantTimeCompare([]byte(user), []byte(username)) == 1
+		passMatch := subtle.ConstantTimeCompare([]byte(pass), []byte(password)) == 1
+		if !ok || !userMatch || !passMatch {
+			w.Header().Set("WWW-Authenticate", `Basic realm="metrics"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,230 @@
+package metricsserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/MonishJuspay/voice-orchestrator/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// get fetches path from srv, optionally with basic auth credentials
+func get(t *testing.T, srv *httptest.Server, path, user, pass string) (int, string) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+path, nil)
+	require.NoError(t, err)
+	if user != "" {
+		req.SetBasicAuth(user, pass)
+	}
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(body)
+}
+
+// writeKeyPair writes a self-signed certificate for 127.0.0.1 and its key into a temp dir
+func writeKeyPair(t *testing.T) (certFile, keyFile string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "metricsserver-test"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
+
+func TestNewValidatesConfig(t *testing.T) {
+	certFile, keyFile := writeKeyPair(t)
+
+	tests := []struct {
+		name     string
+		cfg      Config
+		errorMsg string
+	}{
+		{name: "plain", cfg: Config{Addr: ":9090"}},
+		{name: "tls and auth", cfg: Config{TLSCertFile: certFile, TLSKeyFile: keyFile, BasicAuthUsername: "u", BasicAuthPassword: "p"}},
+		{name: "missing tls files", cfg: Config{TLSCertFile: "missing.pem", TLSKeyFile: "missing.key"}, errorMsg: "failed to load metrics TLS key pair"},
+		{name: "cert used as key", cfg: Config{TLSCertFile: certFile, TLSKeyFile: certFile}, errorMsg: "failed to load metrics TLS key pair"},
+		{name: "cert without key", cfg: Config{TLSCertFile: "c.pem"}, errorMsg: "both a certificate and a key"},
+		{name: "key without cert", cfg: Config{TLSKeyFile: "k.pem"}, errorMsg: "both a certificate and a key"},
+		{name: "username without password", cfg: Config{BasicAuthUsername: "u"}, errorMsg: "both a username and a password"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(tt.cfg)
+			if tt.errorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServesDefaultRegistry(t *testing.T) {
+	counter := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "metricsserver_test_total",
+		Help: "Registered into the default registry by the test.",
+	})
+	require.NoError(t, prometheus.Register(counter))
+	t.Cleanup(func() { prometheus.Unregister(counter) })
+	counter.Inc()
+
+	s, err := New(Config{})
+	require.NoError(t, err)
+	srv := httptest.NewServer(s.Handler())
+	defer srv.Close()
+
+	status, body := get(t, srv, "/metrics", "", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Contains(t, body, "metricsserver_test_total 1")
+	assert.Contains(t, body, "go_goroutines")
+
+	status, body = get(t, srv, "/health", "", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "ok", body)
+}
+
+func TestBasicAuth(t *testing.T) {
+	s, err := New(Config{BasicAuthUsername: "prometheus", BasicAuthPassword: "s3cret"})
+	require.NoError(t, err)
+	srv := httptest.NewTLSServer(s.Handler())
+	defer srv.Close()
+
+	tests := []struct {
+		name           string
+		user, pass     string
+		expectedStatus int
+	}{
+		{name: "no credentials", expectedStatus: http.StatusUnauthorized},
+		{name: "wrong password", user: "prometheus", pass: "wrong", expectedStatus: http.StatusUnauthorized},
+		{name: "wrong username", user: "admin", pass: "s3cret", expectedStatus: http.StatusUnauthorized},
+		{name: "valid", user: "prometheus", pass: "s3cret", expectedStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, _ := get(t, srv, "/metrics", tt.user, tt.pass)
+			assert.Equal(t, tt.expectedStatus, status)
+		})
+	}
+
+	status, _ := get(t, srv, "/health", "", "")
+	assert.Equal(t, http.StatusOK, status, "health stays open for probes")
+}
+
+func TestRunShutsDownOnCancel(t *testing.T) {
+	logger.Log = zap.NewNop()
+	s, err := New(Config{Addr: "127.0.0.1:0"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after cancel")
+	}
+}
+
+func TestRunServesTLS(t *testing.T) {
+	logger.Log = zap.NewNop()
+	certFile, keyFile := writeKeyPair(t)
+
+	addr := freeAddr(t)
+	s, err := New(Config{Addr: addr, TLSCertFile: certFile, TLSKeyFile: keyFile})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = s.Run(ctx) }()
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	require.Eventually(t, func() bool {
+		resp, err := client.Get("https://" + addr + "/health")
+		if err != nil {
+			return false
+		}
+		resp.Body.Close()
+		return resp.StatusCode == http.StatusOK
+	}, 2*time.Second, 20*time.Millisecond)
+}
+
+func TestRunFailsWhenPortIsTaken(t *testing.T) {
+	logger.Log = zap.NewNop()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	s, err := New(Config{Addr: ln.Addr().String()})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- s.Run(ctx) }()
+
+	select {
+	case err := <-done:
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "metrics server failed")
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not report the port conflict")
+	}
+}
+
+// freeAddr returns a loopback address that was free when checked
+func freeAddr(t *testing.T) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+	return addr
+}